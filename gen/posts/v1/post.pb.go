@@ -23,17 +23,173 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Who may see a post. Unspecified is treated as public.
+type Audience int32
+
+const (
+	Audience_AUDIENCE_UNSPECIFIED   Audience = 0
+	Audience_AUDIENCE_PUBLIC        Audience = 1
+	Audience_AUDIENCE_CLOSE_FRIENDS Audience = 2 // only the author and users on the author's close friends list
+)
+
+// Enum value maps for Audience.
+var (
+	Audience_name = map[int32]string{
+		0: "AUDIENCE_UNSPECIFIED",
+		1: "AUDIENCE_PUBLIC",
+		2: "AUDIENCE_CLOSE_FRIENDS",
+	}
+	Audience_value = map[string]int32{
+		"AUDIENCE_UNSPECIFIED":   0,
+		"AUDIENCE_PUBLIC":        1,
+		"AUDIENCE_CLOSE_FRIENDS": 2,
+	}
+)
+
+func (x Audience) Enum() *Audience {
+	p := new(Audience)
+	*p = x
+	return p
+}
+
+func (x Audience) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Audience) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_v1_post_proto_enumTypes[0].Descriptor()
+}
+
+func (Audience) Type() protoreflect.EnumType {
+	return &file_posts_v1_post_proto_enumTypes[0]
+}
+
+func (x Audience) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Audience.Descriptor instead.
+func (Audience) EnumDescriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{0}
+}
+
+type MediaType int32
+
+const (
+	MediaType_MEDIA_TYPE_UNSPECIFIED MediaType = 0
+	MediaType_MEDIA_TYPE_IMAGE       MediaType = 1
+)
+
+// Enum value maps for MediaType.
+var (
+	MediaType_name = map[int32]string{
+		0: "MEDIA_TYPE_UNSPECIFIED",
+		1: "MEDIA_TYPE_IMAGE",
+	}
+	MediaType_value = map[string]int32{
+		"MEDIA_TYPE_UNSPECIFIED": 0,
+		"MEDIA_TYPE_IMAGE":       1,
+	}
+)
+
+func (x MediaType) Enum() *MediaType {
+	p := new(MediaType)
+	*p = x
+	return p
+}
+
+func (x MediaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_v1_post_proto_enumTypes[1].Descriptor()
+}
+
+func (MediaType) Type() protoreflect.EnumType {
+	return &file_posts_v1_post_proto_enumTypes[1]
+}
+
+func (x MediaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaType.Descriptor instead.
+func (MediaType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{1}
+}
+
+type SearchSort int32
+
+const (
+	SearchSort_SEARCH_SORT_UNSPECIFIED SearchSort = 0 // relevance
+	SearchSort_SEARCH_SORT_RELEVANCE   SearchSort = 1
+	SearchSort_SEARCH_SORT_RECENCY     SearchSort = 2
+)
+
+// Enum value maps for SearchSort.
+var (
+	SearchSort_name = map[int32]string{
+		0: "SEARCH_SORT_UNSPECIFIED",
+		1: "SEARCH_SORT_RELEVANCE",
+		2: "SEARCH_SORT_RECENCY",
+	}
+	SearchSort_value = map[string]int32{
+		"SEARCH_SORT_UNSPECIFIED": 0,
+		"SEARCH_SORT_RELEVANCE":   1,
+		"SEARCH_SORT_RECENCY":     2,
+	}
+)
+
+func (x SearchSort) Enum() *SearchSort {
+	p := new(SearchSort)
+	*p = x
+	return p
+}
+
+func (x SearchSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_v1_post_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchSort) Type() protoreflect.EnumType {
+	return &file_posts_v1_post_proto_enumTypes[2]
+}
+
+func (x SearchSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchSort.Descriptor instead.
+func (SearchSort) EnumDescriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{2}
+}
+
 // Core Post model
 type Post struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Content  string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	ImageUrl string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ImageUrl string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // optional; new posts attach uploads through media instead
 	// int64 user_id = 4; // ID of the user who created the post
-	User          *v1.User               `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"` // User who created the post
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	User             *v1.User               `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"` // User who created the post
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Audience         Audience               `protobuf:"varint,6,opt,name=audience,proto3,enum=posts.v1.Audience" json:"audience,omitempty"`
+	ReplyToPostId    int64                  `protobuf:"varint,7,opt,name=reply_to_post_id,json=replyToPostId,proto3" json:"reply_to_post_id,omitempty"`                // parent post, 0 for top-level posts
+	RootPostId       int64                  `protobuf:"varint,8,opt,name=root_post_id,json=rootPostId,proto3" json:"root_post_id,omitempty"`                           // first post of the conversation, 0 for top-level posts
+	QuotePostId      int64                  `protobuf:"varint,9,opt,name=quote_post_id,json=quotePostId,proto3" json:"quote_post_id,omitempty"`                        // post quoted by this one
+	RepostOfPostId   int64                  `protobuf:"varint,10,opt,name=repost_of_post_id,json=repostOfPostId,proto3" json:"repost_of_post_id,omitempty"`            // set on repost entries in ListPostsByUser; id is the repost's own
+	EmbeddedPost     *Post                  `protobuf:"bytes,11,opt,name=embedded_post,json=embeddedPost,proto3" json:"embedded_post,omitempty"`                       // the quoted or reposted post, filled in on read when visible
+	EditedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`                                   // last edit, unset if never edited
+	Hashtags         []string               `protobuf:"bytes,13,rep,name=hashtags,proto3" json:"hashtags,omitempty"`                                                   // normalized, in order of appearance
+	Entities         []*v1.TextEntity       `protobuf:"bytes,14,rep,name=entities,proto3" json:"entities,omitempty"`                                                   // hashtags, mentions and URLs parsed from content
+	MentionedUserIds []int64                `protobuf:"varint,15,rep,packed,name=mentioned_user_ids,json=mentionedUserIds,proto3" json:"mentioned_user_ids,omitempty"` // users the mentions resolved to, in order of appearance
+	Media            []*Media               `protobuf:"bytes,16,rep,name=media,proto3" json:"media,omitempty"`                                                         // attachments, in display order
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Post) Reset() {
@@ -101,6 +257,176 @@ func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Post) GetAudience() Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return Audience_AUDIENCE_UNSPECIFIED
+}
+
+func (x *Post) GetReplyToPostId() int64 {
+	if x != nil {
+		return x.ReplyToPostId
+	}
+	return 0
+}
+
+func (x *Post) GetRootPostId() int64 {
+	if x != nil {
+		return x.RootPostId
+	}
+	return 0
+}
+
+func (x *Post) GetQuotePostId() int64 {
+	if x != nil {
+		return x.QuotePostId
+	}
+	return 0
+}
+
+func (x *Post) GetRepostOfPostId() int64 {
+	if x != nil {
+		return x.RepostOfPostId
+	}
+	return 0
+}
+
+func (x *Post) GetEmbeddedPost() *Post {
+	if x != nil {
+		return x.EmbeddedPost
+	}
+	return nil
+}
+
+func (x *Post) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Post) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *Post) GetEntities() []*v1.TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Post) GetMentionedUserIds() []int64 {
+	if x != nil {
+		return x.MentionedUserIds
+	}
+	return nil
+}
+
+func (x *Post) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// An uploaded file attached to a post.
+type Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          MediaType              `protobuf:"varint,2,opt,name=type,proto3,enum=posts.v1.MediaType" json:"type,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`   // pixels
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"` // pixels
+	AltText       string                 `protobuf:"bytes,7,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_posts_v1_post_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Media) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Media) GetType() MediaType {
+	if x != nil {
+		return x.Type
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *Media) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Media) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Media) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Media) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Media) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
 // For transactional outbox or event publishing
 type OutboxEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -114,7 +440,7 @@ type OutboxEvent struct {
 
 func (x *OutboxEvent) Reset() {
 	*x = OutboxEvent{}
-	mi := &file_posts_v1_post_proto_msgTypes[1]
+	mi := &file_posts_v1_post_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -126,7 +452,7 @@ func (x *OutboxEvent) String() string {
 func (*OutboxEvent) ProtoMessage() {}
 
 func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[1]
+	mi := &file_posts_v1_post_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,7 +465,7 @@ func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutboxEvent.ProtoReflect.Descriptor instead.
 func (*OutboxEvent) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{1}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OutboxEvent) GetEventId() string {
@@ -179,7 +505,7 @@ type CreatePostIndexedByUserRequest struct {
 
 func (x *CreatePostIndexedByUserRequest) Reset() {
 	*x = CreatePostIndexedByUserRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[2]
+	mi := &file_posts_v1_post_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +517,7 @@ func (x *CreatePostIndexedByUserRequest) String() string {
 func (*CreatePostIndexedByUserRequest) ProtoMessage() {}
 
 func (x *CreatePostIndexedByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[2]
+	mi := &file_posts_v1_post_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +530,7 @@ func (x *CreatePostIndexedByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostIndexedByUserRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostIndexedByUserRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{2}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePostIndexedByUserRequest) GetPost() *Post {
@@ -223,7 +549,7 @@ type CreatePostIndexedByUserResponse struct {
 
 func (x *CreatePostIndexedByUserResponse) Reset() {
 	*x = CreatePostIndexedByUserResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[3]
+	mi := &file_posts_v1_post_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +561,7 @@ func (x *CreatePostIndexedByUserResponse) String() string {
 func (*CreatePostIndexedByUserResponse) ProtoMessage() {}
 
 func (x *CreatePostIndexedByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[3]
+	mi := &file_posts_v1_post_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +574,7 @@ func (x *CreatePostIndexedByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostIndexedByUserResponse.ProtoReflect.Descriptor instead.
 func (*CreatePostIndexedByUserResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{3}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreatePostIndexedByUserResponse) GetSuccess() bool {
@@ -267,7 +593,7 @@ type InitializePostEngagementsRequest struct {
 
 func (x *InitializePostEngagementsRequest) Reset() {
 	*x = InitializePostEngagementsRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[4]
+	mi := &file_posts_v1_post_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +605,7 @@ func (x *InitializePostEngagementsRequest) String() string {
 func (*InitializePostEngagementsRequest) ProtoMessage() {}
 
 func (x *InitializePostEngagementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[4]
+	mi := &file_posts_v1_post_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +618,7 @@ func (x *InitializePostEngagementsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitializePostEngagementsRequest.ProtoReflect.Descriptor instead.
 func (*InitializePostEngagementsRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{4}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InitializePostEngagementsRequest) GetPostId() int64 {
@@ -311,7 +637,7 @@ type InitializePostEngagementsResponse struct {
 
 func (x *InitializePostEngagementsResponse) Reset() {
 	*x = InitializePostEngagementsResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[5]
+	mi := &file_posts_v1_post_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +649,7 @@ func (x *InitializePostEngagementsResponse) String() string {
 func (*InitializePostEngagementsResponse) ProtoMessage() {}
 
 func (x *InitializePostEngagementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[5]
+	mi := &file_posts_v1_post_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +662,7 @@ func (x *InitializePostEngagementsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InitializePostEngagementsResponse.ProtoReflect.Descriptor instead.
 func (*InitializePostEngagementsResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{5}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *InitializePostEngagementsResponse) GetTrue() bool {
@@ -351,13 +677,14 @@ type Like struct {
 	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // set on like.deleted events
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Like) Reset() {
 	*x = Like{}
-	mi := &file_posts_v1_post_proto_msgTypes[6]
+	mi := &file_posts_v1_post_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -369,7 +696,7 @@ func (x *Like) String() string {
 func (*Like) ProtoMessage() {}
 
 func (x *Like) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[6]
+	mi := &file_posts_v1_post_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +709,7 @@ func (x *Like) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Like.ProtoReflect.Descriptor instead.
 func (*Like) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{6}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Like) GetPostId() int64 {
@@ -406,6 +733,13 @@ func (x *Like) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Like) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type CreateLikeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -416,7 +750,7 @@ type CreateLikeRequest struct {
 
 func (x *CreateLikeRequest) Reset() {
 	*x = CreateLikeRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[7]
+	mi := &file_posts_v1_post_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +762,7 @@ func (x *CreateLikeRequest) String() string {
 func (*CreateLikeRequest) ProtoMessage() {}
 
 func (x *CreateLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[7]
+	mi := &file_posts_v1_post_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +775,7 @@ func (x *CreateLikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLikeRequest.ProtoReflect.Descriptor instead.
 func (*CreateLikeRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{7}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateLikeRequest) GetPostId() int64 {
@@ -461,13 +795,14 @@ func (x *CreateLikeRequest) GetUserId() int64 {
 type CreateLikeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Like          *Like                  `protobuf:"bytes,1,opt,name=like,proto3" json:"like,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // false when the user had already liked the post; like is the existing one
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLikeResponse) Reset() {
 	*x = CreateLikeResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[8]
+	mi := &file_posts_v1_post_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -479,7 +814,7 @@ func (x *CreateLikeResponse) String() string {
 func (*CreateLikeResponse) ProtoMessage() {}
 
 func (x *CreateLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[8]
+	mi := &file_posts_v1_post_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +827,7 @@ func (x *CreateLikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLikeResponse.ProtoReflect.Descriptor instead.
 func (*CreateLikeResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{8}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateLikeResponse) GetLike() *Like {
@@ -502,6 +837,13 @@ func (x *CreateLikeResponse) GetLike() *Like {
 	return nil
 }
 
+func (x *CreateLikeResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
 type CreateLikeByUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Like          *Like                  `protobuf:"bytes,1,opt,name=like,proto3" json:"like,omitempty"`
@@ -511,7 +853,7 @@ type CreateLikeByUserRequest struct {
 
 func (x *CreateLikeByUserRequest) Reset() {
 	*x = CreateLikeByUserRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[9]
+	mi := &file_posts_v1_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -523,7 +865,7 @@ func (x *CreateLikeByUserRequest) String() string {
 func (*CreateLikeByUserRequest) ProtoMessage() {}
 
 func (x *CreateLikeByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[9]
+	mi := &file_posts_v1_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +878,7 @@ func (x *CreateLikeByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLikeByUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateLikeByUserRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{9}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateLikeByUserRequest) GetLike() *Like {
@@ -555,7 +897,7 @@ type CreateLikeByUserResponse struct {
 
 func (x *CreateLikeByUserResponse) Reset() {
 	*x = CreateLikeByUserResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[10]
+	mi := &file_posts_v1_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +909,7 @@ func (x *CreateLikeByUserResponse) String() string {
 func (*CreateLikeByUserResponse) ProtoMessage() {}
 
 func (x *CreateLikeByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[10]
+	mi := &file_posts_v1_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +922,7 @@ func (x *CreateLikeByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLikeByUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateLikeByUserResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{10}
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateLikeByUserResponse) GetCreated() bool {
@@ -590,28 +932,29 @@ func (x *CreateLikeByUserResponse) GetCreated() bool {
 	return false
 }
 
-type IncrementPostLikesRequest struct {
+type DeleteLikeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncrementPostLikesRequest) Reset() {
-	*x = IncrementPostLikesRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[11]
+func (x *DeleteLikeRequest) Reset() {
+	*x = DeleteLikeRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncrementPostLikesRequest) String() string {
+func (x *DeleteLikeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementPostLikesRequest) ProtoMessage() {}
+func (*DeleteLikeRequest) ProtoMessage() {}
 
-func (x *IncrementPostLikesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[11]
+func (x *DeleteLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,40 +965,47 @@ func (x *IncrementPostLikesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementPostLikesRequest.ProtoReflect.Descriptor instead.
-func (*IncrementPostLikesRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteLikeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLikeRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *IncrementPostLikesRequest) GetPostId() int64 {
+func (x *DeleteLikeRequest) GetPostId() int64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-type IncrementPostLikesResponse struct {
+func (x *DeleteLikeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteLikeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Incremented   bool                   `protobuf:"varint,1,opt,name=incremented,proto3" json:"incremented,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // false when the user had not liked the post
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncrementPostLikesResponse) Reset() {
-	*x = IncrementPostLikesResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[12]
+func (x *DeleteLikeResponse) Reset() {
+	*x = DeleteLikeResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncrementPostLikesResponse) String() string {
+func (x *DeleteLikeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementPostLikesResponse) ProtoMessage() {}
+func (*DeleteLikeResponse) ProtoMessage() {}
 
-func (x *IncrementPostLikesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[12]
+func (x *DeleteLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,44 +1016,41 @@ func (x *IncrementPostLikesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementPostLikesResponse.ProtoReflect.Descriptor instead.
-func (*IncrementPostLikesResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteLikeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLikeResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *IncrementPostLikesResponse) GetIncremented() bool {
+func (x *DeleteLikeResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Incremented
+		return x.Deleted
 	}
 	return false
 }
 
-type GetPostWithMetadataResponse struct {
+type DeleteLikeByUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
-	LikeCount     int64                  `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	ShareCount    int64                  `protobuf:"varint,3,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
-	CommentCount  int64                  `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	RepostCount   int64                  `protobuf:"varint,5,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	Like          *Like                  `protobuf:"bytes,1,opt,name=like,proto3" json:"like,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // like.deleted event id; like_count is decremented once per event
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostWithMetadataResponse) Reset() {
-	*x = GetPostWithMetadataResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[13]
+func (x *DeleteLikeByUserRequest) Reset() {
+	*x = DeleteLikeByUserRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostWithMetadataResponse) String() string {
+func (x *DeleteLikeByUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostWithMetadataResponse) ProtoMessage() {}
+func (*DeleteLikeByUserRequest) ProtoMessage() {}
 
-func (x *GetPostWithMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[13]
+func (x *DeleteLikeByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,68 +1061,152 @@ func (x *GetPostWithMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostWithMetadataResponse.ProtoReflect.Descriptor instead.
-func (*GetPostWithMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteLikeByUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLikeByUserRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetPostWithMetadataResponse) GetPost() *Post {
+func (x *DeleteLikeByUserRequest) GetLike() *Like {
 	if x != nil {
-		return x.Post
+		return x.Like
 	}
 	return nil
 }
 
-func (x *GetPostWithMetadataResponse) GetLikeCount() int64 {
+func (x *DeleteLikeByUserRequest) GetEventId() string {
 	if x != nil {
-		return x.LikeCount
+		return x.EventId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPostWithMetadataResponse) GetShareCount() int64 {
+type DeleteLikeByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // false when the event had already been applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLikeByUserResponse) Reset() {
+	*x = DeleteLikeByUserResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLikeByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLikeByUserResponse) ProtoMessage() {}
+
+func (x *DeleteLikeByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[15]
 	if x != nil {
-		return x.ShareCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetPostWithMetadataResponse) GetCommentCount() int64 {
+// Deprecated: Use DeleteLikeByUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLikeByUserResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteLikeByUserResponse) GetDeleted() bool {
 	if x != nil {
-		return x.CommentCount
+		return x.Deleted
+	}
+	return false
+}
+
+type ListLikesByPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLikesByPostRequest) Reset() {
+	*x = ListLikesByPostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikesByPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikesByPostRequest) ProtoMessage() {}
+
+func (x *ListLikesByPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikesByPostRequest.ProtoReflect.Descriptor instead.
+func (*ListLikesByPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListLikesByPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *GetPostWithMetadataResponse) GetRepostCount() int64 {
+func (x *ListLikesByPostRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RepostCount
+		return x.PageSize
 	}
 	return 0
 }
 
-type UpdatePostEngagementsRequest struct {
+func (x *ListLikesByPostRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type ListLikesByPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Users         []*v1.User             `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"` // public profile fields only
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostEngagementsRequest) Reset() {
-	*x = UpdatePostEngagementsRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[14]
+func (x *ListLikesByPostResponse) Reset() {
+	*x = ListLikesByPostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostEngagementsRequest) String() string {
+func (x *ListLikesByPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostEngagementsRequest) ProtoMessage() {}
+func (*ListLikesByPostResponse) ProtoMessage() {}
 
-func (x *UpdatePostEngagementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[14]
+func (x *ListLikesByPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,40 +1217,109 @@ func (x *UpdatePostEngagementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostEngagementsRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostEngagementsRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListLikesByPostResponse.ProtoReflect.Descriptor instead.
+func (*ListLikesByPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdatePostEngagementsRequest) GetPostId() int64 {
+func (x *ListLikesByPostResponse) GetUsers() []*v1.User {
 	if x != nil {
-		return x.PostId
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListLikesByPostResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type ListLikedPostsByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLikedPostsByUserRequest) Reset() {
+	*x = ListLikedPostsByUserRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikedPostsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikedPostsByUserRequest) ProtoMessage() {}
+
+func (x *ListLikedPostsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikedPostsByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListLikedPostsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListLikedPostsByUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-type UpdatePostEngagementsResponse struct {
+func (x *ListLikedPostsByUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLikedPostsByUserRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type ListLikedPostsByUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // most recently liked first
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostEngagementsResponse) Reset() {
-	*x = UpdatePostEngagementsResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[15]
+func (x *ListLikedPostsByUserResponse) Reset() {
+	*x = ListLikedPostsByUserResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostEngagementsResponse) String() string {
+func (x *ListLikedPostsByUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostEngagementsResponse) ProtoMessage() {}
+func (*ListLikedPostsByUserResponse) ProtoMessage() {}
 
-func (x *UpdatePostEngagementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[15]
+func (x *ListLikedPostsByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,45 +1327,2554 @@ func (x *UpdatePostEngagementsResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikedPostsByUserResponse.ProtoReflect.Descriptor instead.
+func (*ListLikedPostsByUserResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListLikedPostsByUserResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListLikedPostsByUserResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+func (x *ListLikedPostsByUserResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+type IncrementPostLikesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // outbox event id; when set, the increment is applied at most once per event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementPostLikesRequest) Reset() {
+	*x = IncrementPostLikesRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementPostLikesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementPostLikesRequest) ProtoMessage() {}
+
+func (x *IncrementPostLikesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementPostLikesRequest.ProtoReflect.Descriptor instead.
+func (*IncrementPostLikesRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IncrementPostLikesRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *IncrementPostLikesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type IncrementPostLikesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incremented   bool                   `protobuf:"varint,1,opt,name=incremented,proto3" json:"incremented,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementPostLikesResponse) Reset() {
+	*x = IncrementPostLikesResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementPostLikesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementPostLikesResponse) ProtoMessage() {}
+
+func (x *IncrementPostLikesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementPostLikesResponse.ProtoReflect.Descriptor instead.
+func (*IncrementPostLikesResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *IncrementPostLikesResponse) GetIncremented() bool {
+	if x != nil {
+		return x.Incremented
+	}
+	return false
+}
+
+type IncrementUserPostCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // post.created event id; a redelivered event is applied once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementUserPostCountRequest) Reset() {
+	*x = IncrementUserPostCountRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementUserPostCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementUserPostCountRequest) ProtoMessage() {}
+
+func (x *IncrementUserPostCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementUserPostCountRequest.ProtoReflect.Descriptor instead.
+func (*IncrementUserPostCountRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IncrementUserPostCountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IncrementUserPostCountRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type IncrementUserPostCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incremented   bool                   `protobuf:"varint,1,opt,name=incremented,proto3" json:"incremented,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementUserPostCountResponse) Reset() {
+	*x = IncrementUserPostCountResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementUserPostCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementUserPostCountResponse) ProtoMessage() {}
+
+func (x *IncrementUserPostCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementUserPostCountResponse.ProtoReflect.Descriptor instead.
+func (*IncrementUserPostCountResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *IncrementUserPostCountResponse) GetIncremented() bool {
+	if x != nil {
+		return x.Incremented
+	}
+	return false
+}
+
+type GetThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`         // replies per page
+	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"` // from a previous GetThreadResponse, for the next page of replies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetThreadRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type GetThreadResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Ancestors []*Post                `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"` // root first, ending with the parent of post
+	Post      *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	// Replies below post at any depth, in the order they were posted. Each reply's
+	// reply_to_post_id places it in the tree.
+	Replies       []*Post                `protobuf:"bytes,3,rep,name=replies,proto3" json:"replies,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,4,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`                                                                               // pass to GetThread for the next page; empty on the last page
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,5,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetThreadResponse) GetAncestors() []*Post {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetReplies() []*Post {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+// Direct replies only; GetThread walks the whole tree below a post.
+type ListRepliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepliesRequest) Reset() {
+	*x = ListRepliesRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepliesRequest) ProtoMessage() {}
+
+func (x *ListRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepliesRequest.ProtoReflect.Descriptor instead.
+func (*ListRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListRepliesRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListRepliesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRepliesRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type ListRepliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepliesResponse) Reset() {
+	*x = ListRepliesResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepliesResponse) ProtoMessage() {}
+
+func (x *ListRepliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepliesResponse.ProtoReflect.Descriptor instead.
+func (*ListRepliesResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListRepliesResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListRepliesResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+func (x *ListRepliesResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+type CreateReplyIndexedByPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reply         *Post                  `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReplyIndexedByPostRequest) Reset() {
+	*x = CreateReplyIndexedByPostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReplyIndexedByPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReplyIndexedByPostRequest) ProtoMessage() {}
+
+func (x *CreateReplyIndexedByPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReplyIndexedByPostRequest.ProtoReflect.Descriptor instead.
+func (*CreateReplyIndexedByPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateReplyIndexedByPostRequest) GetReply() *Post {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type CreateReplyIndexedByPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Indexed       bool                   `protobuf:"varint,1,opt,name=indexed,proto3" json:"indexed,omitempty"` // false when the reply had already been indexed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReplyIndexedByPostResponse) Reset() {
+	*x = CreateReplyIndexedByPostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReplyIndexedByPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReplyIndexedByPostResponse) ProtoMessage() {}
+
+func (x *CreateReplyIndexedByPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReplyIndexedByPostResponse.ProtoReflect.Descriptor instead.
+func (*CreateReplyIndexedByPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateReplyIndexedByPostResponse) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+type Repost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`       // reposted post
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // reposting user
+	RepostId      int64                  `protobuf:"varint,3,opt,name=repost_id,json=repostId,proto3" json:"repost_id,omitempty"` // id of the entry in the reposting user's posts
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Repost) Reset() {
+	*x = Repost{}
+	mi := &file_posts_v1_post_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repost) ProtoMessage() {}
+
+func (x *Repost) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repost.ProtoReflect.Descriptor instead.
+func (*Repost) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Repost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Repost) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Repost) GetRepostId() int64 {
+	if x != nil {
+		return x.RepostId
+	}
+	return 0
+}
+
+func (x *Repost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RepostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepostRequest) Reset() {
+	*x = RepostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostRequest) ProtoMessage() {}
+
+func (x *RepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostRequest.ProtoReflect.Descriptor instead.
+func (*RepostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RepostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RepostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repost        *Repost                `protobuf:"bytes,1,opt,name=repost,proto3" json:"repost,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // false when the user had already reposted the post
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepostResponse) Reset() {
+	*x = RepostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostResponse) ProtoMessage() {}
+
+func (x *RepostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostResponse.ProtoReflect.Descriptor instead.
+func (*RepostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RepostResponse) GetRepost() *Repost {
+	if x != nil {
+		return x.Repost
+	}
+	return nil
+}
+
+func (x *RepostResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type UndoRepostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoRepostRequest) Reset() {
+	*x = UndoRepostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoRepostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRepostRequest) ProtoMessage() {}
+
+func (x *UndoRepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRepostRequest.ProtoReflect.Descriptor instead.
+func (*UndoRepostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UndoRepostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UndoRepostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoRepostResponse) Reset() {
+	*x = UndoRepostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoRepostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRepostResponse) ProtoMessage() {}
+
+func (x *UndoRepostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRepostResponse.ProtoReflect.Descriptor instead.
+func (*UndoRepostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UndoRepostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type IncrementPostRepostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // post.reposted event id; a redelivered event is applied once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementPostRepostsRequest) Reset() {
+	*x = IncrementPostRepostsRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementPostRepostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementPostRepostsRequest) ProtoMessage() {}
+
+func (x *IncrementPostRepostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementPostRepostsRequest.ProtoReflect.Descriptor instead.
+func (*IncrementPostRepostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IncrementPostRepostsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *IncrementPostRepostsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type IncrementPostRepostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incremented   bool                   `protobuf:"varint,1,opt,name=incremented,proto3" json:"incremented,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementPostRepostsResponse) Reset() {
+	*x = IncrementPostRepostsResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementPostRepostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementPostRepostsResponse) ProtoMessage() {}
+
+func (x *IncrementPostRepostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementPostRepostsResponse.ProtoReflect.Descriptor instead.
+func (*IncrementPostRepostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IncrementPostRepostsResponse) GetIncremented() bool {
+	if x != nil {
+		return x.Incremented
+	}
+	return false
+}
+
+type DecrementPostRepostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // post.unreposted event id; a redelivered event is applied once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrementPostRepostsRequest) Reset() {
+	*x = DecrementPostRepostsRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementPostRepostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementPostRepostsRequest) ProtoMessage() {}
+
+func (x *DecrementPostRepostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementPostRepostsRequest.ProtoReflect.Descriptor instead.
+func (*DecrementPostRepostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DecrementPostRepostsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *DecrementPostRepostsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type DecrementPostRepostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decremented   bool                   `protobuf:"varint,1,opt,name=decremented,proto3" json:"decremented,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrementPostRepostsResponse) Reset() {
+	*x = DecrementPostRepostsResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementPostRepostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementPostRepostsResponse) ProtoMessage() {}
+
+func (x *DecrementPostRepostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementPostRepostsResponse.ProtoReflect.Descriptor instead.
+func (*DecrementPostRepostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DecrementPostRepostsResponse) GetDecremented() bool {
+	if x != nil {
+		return x.Decremented
+	}
+	return false
+}
+
+type GetPostWithMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	LikeCount     int64                  `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	ShareCount    int64                  `protobuf:"varint,3,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	RepostCount   int64                  `protobuf:"varint,5,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	ViewerState   *ViewerState           `protobuf:"bytes,6,opt,name=viewer_state,json=viewerState,proto3" json:"viewer_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostWithMetadataResponse) Reset() {
+	*x = GetPostWithMetadataResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostWithMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostWithMetadataResponse) ProtoMessage() {}
+
+func (x *GetPostWithMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostWithMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetPostWithMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPostWithMetadataResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *GetPostWithMetadataResponse) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *GetPostWithMetadataResponse) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+func (x *GetPostWithMetadataResponse) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *GetPostWithMetadataResponse) GetRepostCount() int64 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *GetPostWithMetadataResponse) GetViewerState() *ViewerState {
+	if x != nil {
+		return x.ViewerState
+	}
+	return nil
+}
+
+// What the calling user has done to a post. All false for anonymous callers.
+type ViewerState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Liked         bool                   `protobuf:"varint,1,opt,name=liked,proto3" json:"liked,omitempty"`
+	Reposted      bool                   `protobuf:"varint,2,opt,name=reposted,proto3" json:"reposted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewerState) Reset() {
+	*x = ViewerState{}
+	mi := &file_posts_v1_post_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewerState) ProtoMessage() {}
+
+func (x *ViewerState) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewerState.ProtoReflect.Descriptor instead.
+func (*ViewerState) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ViewerState) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *ViewerState) GetReposted() bool {
+	if x != nil {
+		return x.Reposted
+	}
+	return false
+}
+
+type UpdatePostEngagementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostEngagementsRequest) Reset() {
+	*x = UpdatePostEngagementsRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostEngagementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostEngagementsRequest) ProtoMessage() {}
+
+func (x *UpdatePostEngagementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostEngagementsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostEngagementsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdatePostEngagementsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UpdatePostEngagementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostEngagementsResponse) Reset() {
+	*x = UpdatePostEngagementsResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostEngagementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostEngagementsResponse) ProtoMessage() {}
+
+func (x *UpdatePostEngagementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostEngagementsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostEngagementsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdatePostEngagementsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // optional
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Audience      Audience               `protobuf:"varint,4,opt,name=audience,proto3,enum=posts.v1.Audience" json:"audience,omitempty"`
+	ReplyToPostId int64                  `protobuf:"varint,5,opt,name=reply_to_post_id,json=replyToPostId,proto3" json:"reply_to_post_id,omitempty"`
+	QuotePostId   int64                  `protobuf:"varint,6,opt,name=quote_post_id,json=quotePostId,proto3" json:"quote_post_id,omitempty"`
+	Media         []*MediaAttachment     `protobuf:"bytes,7,rep,name=media,proto3" json:"media,omitempty"` // at most four, uploaded by the author
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetAudience() Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return Audience_AUDIENCE_UNSPECIFIED
+}
+
+func (x *CreatePostRequest) GetReplyToPostId() int64 {
+	if x != nil {
+		return x.ReplyToPostId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetQuotePostId() int64 {
+	if x != nil {
+		return x.QuotePostId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetMedia() []*MediaAttachment {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type MediaAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MediaId       int64                  `protobuf:"varint,1,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"` // from CreateUpload, after the upload finished
+	AltText       string                 `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaAttachment) Reset() {
+	*x = MediaAttachment{}
+	mi := &file_posts_v1_post_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaAttachment) ProtoMessage() {}
+
+func (x *MediaAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaAttachment.ProtoReflect.Descriptor instead.
+func (*MediaAttachment) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MediaAttachment) GetMediaId() int64 {
+	if x != nil {
+		return x.MediaId
+	}
+	return 0
+}
+
+func (x *MediaAttachment) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreatePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostResponse) Reset() {
+	*x = GetPostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostResponse) ProtoMessage() {}
+
+func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
+func (*GetPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type ListPostsByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Filter by user
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`         // Number of posts per page
+	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"` // Cassandra paging state (opaque cursor)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsByUserRequest) Reset() {
+	*x = ListPostsByUserRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsByUserRequest) ProtoMessage() {}
+
+func (x *ListPostsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListPostsByUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPostsByUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostsByUserRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type ListPostsByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`                                                                               // Return for next page query
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsByUserResponse) Reset() {
+	*x = ListPostsByUserResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsByUserResponse) ProtoMessage() {}
+
+func (x *ListPostsByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsByUserResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsByUserResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListPostsByUserResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListPostsByUserResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+func (x *ListPostsByUserResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeletePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeletePostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PostEngagements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LikeCount     int64                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	ShareCount    int64                  `protobuf:"varint,2,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	CommentCount  int64                  `protobuf:"varint,3,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	RepostCount   int64                  `protobuf:"varint,4,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEngagements) Reset() {
+	*x = PostEngagements{}
+	mi := &file_posts_v1_post_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEngagements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEngagements) ProtoMessage() {}
+
+func (x *PostEngagements) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEngagements.ProtoReflect.Descriptor instead.
+func (*PostEngagements) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PostEngagements) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *PostEngagements) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+func (x *PostEngagements) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *PostEngagements) GetRepostCount() int64 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+type GetHomeTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeTimelineRequest) Reset() {
+	*x = GetHomeTimelineRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeTimelineRequest) ProtoMessage() {}
+
+func (x *GetHomeTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetHomeTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetHomeTimelineRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetHomeTimelineRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type GetHomeTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // newest first
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeTimelineResponse) Reset() {
+	*x = GetHomeTimelineResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeTimelineResponse) ProtoMessage() {}
+
+func (x *GetHomeTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetHomeTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetHomeTimelineResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetHomeTimelineResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+func (x *GetHomeTimelineResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+type GetRecommendedFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // empty starts a new session; pass the returned id to page on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendedFeedRequest) Reset() {
+	*x = GetRecommendedFeedRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendedFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedFeedRequest) ProtoMessage() {}
+
+func (x *GetRecommendedFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendedFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetRecommendedFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRecommendedFeedRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetRecommendedFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // best first; never repeats a post within the session
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendedFeedResponse) Reset() {
+	*x = GetRecommendedFeedResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendedFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedFeedResponse) ProtoMessage() {}
+
+func (x *GetRecommendedFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendedFeedResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetRecommendedFeedResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetRecommendedFeedResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetRecommendedFeedResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+type EditPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPostRequest) Reset() {
+	*x = EditPostRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostRequest) ProtoMessage() {}
+
+func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
+func (*EditPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EditPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type EditPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPostResponse) Reset() {
+	*x = EditPostResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResponse) ProtoMessage() {}
+
+func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
+func (*EditPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *EditPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// A version of a post that was replaced by an edit.
+type PostRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // when this version was published
+	ReplacedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"` // when the edit replacing it was made
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_posts_v1_post_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PostRevision) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostRevision) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *PostRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostRevision) GetReplacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return nil
+}
+
+type ListPostRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsRequest) Reset() {
+	*x = ListPostRevisionsRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsRequest) ProtoMessage() {}
+
+func (x *ListPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListPostRevisionsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListPostRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostRevisionsRequest) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type ListPostRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*PostRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // newest first
+	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsResponse) Reset() {
+	*x = ListPostRevisionsResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsResponse) ProtoMessage() {}
+
+func (x *ListPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListPostRevisionsResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListPostRevisionsResponse) GetPagingState() []byte {
+	if x != nil {
+		return x.PagingState
+	}
+	return nil
+}
+
+type DecrementUserPostCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // post.deleted event id; a redelivered event is applied once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrementUserPostCountRequest) Reset() {
+	*x = DecrementUserPostCountRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementUserPostCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementUserPostCountRequest) ProtoMessage() {}
+
+func (x *DecrementUserPostCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementUserPostCountRequest.ProtoReflect.Descriptor instead.
+func (*DecrementUserPostCountRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DecrementUserPostCountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DecrementUserPostCountRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type DecrementUserPostCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decremented   bool                   `protobuf:"varint,1,opt,name=decremented,proto3" json:"decremented,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrementUserPostCountResponse) Reset() {
+	*x = DecrementUserPostCountResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementUserPostCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementUserPostCountResponse) ProtoMessage() {}
+
+func (x *DecrementUserPostCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementUserPostCountResponse.ProtoReflect.Descriptor instead.
+func (*DecrementUserPostCountResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DecrementUserPostCountResponse) GetDecremented() bool {
+	if x != nil {
+		return x.Decremented
+	}
+	return false
+}
+
+type ListPostsByHashtagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"` // with or without the leading '#'
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        []byte                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsByHashtagRequest) Reset() {
+	*x = ListPostsByHashtagRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsByHashtagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsByHashtagRequest) ProtoMessage() {}
+
+func (x *ListPostsByHashtagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsByHashtagRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsByHashtagRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListPostsByHashtagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListPostsByHashtagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostsByHashtagRequest) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type ListPostsByHashtagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`                                                                                                              // newest first
+	Cursor        []byte                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                                                                            // empty when there are no older posts
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsByHashtagResponse) Reset() {
+	*x = ListPostsByHashtagResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsByHashtagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsByHashtagResponse) ProtoMessage() {}
+
+func (x *ListPostsByHashtagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsByHashtagResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsByHashtagResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListPostsByHashtagResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
 }
 
-// Deprecated: Use UpdatePostEngagementsResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostEngagementsResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{15}
+func (x *ListPostsByHashtagResponse) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
 }
 
-func (x *UpdatePostEngagementsResponse) GetSuccess() bool {
+func (x *ListPostsByHashtagResponse) GetViewerStates() map[int64]*ViewerState {
 	if x != nil {
-		return x.Success
+		return x.ViewerStates
 	}
-	return false
+	return nil
 }
 
-type CreatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Payload of post.mentioned, one event per notified user.
+type MentionedEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PostId          int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AuthorId        int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	MentionedUserId int64                  `protobuf:"varint,3,opt,name=mentioned_user_id,json=mentionedUserId,proto3" json:"mentioned_user_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[16]
+func (x *MentionedEvent) Reset() {
+	*x = MentionedEvent{}
+	mi := &file_posts_v1_post_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *MentionedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*MentionedEvent) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[16]
+func (x *MentionedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,54 +3885,62 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MentionedEvent.ProtoReflect.Descriptor instead.
+func (*MentionedEvent) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreatePostRequest) GetContent() string {
+func (x *MentionedEvent) GetPostId() int64 {
 	if x != nil {
-		return x.Content
+		return x.PostId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePostRequest) GetImageUrl() string {
+func (x *MentionedEvent) GetAuthorId() int64 {
 	if x != nil {
-		return x.ImageUrl
+		return x.AuthorId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePostRequest) GetUserId() int64 {
+func (x *MentionedEvent) GetMentionedUserId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.MentionedUserId
 	}
 	return 0
 }
 
-type CreatePostResponse struct {
+func (x *MentionedEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TrendingHashtag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // how far activity in the last hour is above the tag's 24h baseline
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[17]
+func (x *TrendingHashtag) Reset() {
+	*x = TrendingHashtag{}
+	mi := &file_posts_v1_post_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *TrendingHashtag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*TrendingHashtag) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[17]
+func (x *TrendingHashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,40 +3951,47 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TrendingHashtag.ProtoReflect.Descriptor instead.
+func (*TrendingHashtag) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreatePostResponse) GetPost() *Post {
+func (x *TrendingHashtag) GetTag() string {
 	if x != nil {
-		return x.Post
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-type GetPostRequest struct {
+func (x *TrendingHashtag) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetTrendingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[18]
+func (x *GetTrendingRequest) Reset() {
+	*x = GetTrendingRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostRequest) String() string {
+func (x *GetTrendingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*GetTrendingRequest) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[18]
+func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,40 +4002,40 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetTrendingRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetPostRequest) GetPostId() int64 {
+func (x *GetTrendingRequest) GetLimit() int32 {
 	if x != nil {
-		return x.PostId
+		return x.Limit
 	}
 	return 0
 }
 
-type GetPostResponse struct {
+type GetTrendingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Hashtags      []*TrendingHashtag     `protobuf:"bytes,1,rep,name=hashtags,proto3" json:"hashtags,omitempty"` // highest score first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostResponse) Reset() {
-	*x = GetPostResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[19]
+func (x *GetTrendingResponse) Reset() {
+	*x = GetTrendingResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostResponse) String() string {
+func (x *GetTrendingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostResponse) ProtoMessage() {}
+func (*GetTrendingResponse) ProtoMessage() {}
 
-func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[19]
+func (x *GetTrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,42 +4046,43 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
-func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetTrendingResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetPostResponse) GetPost() *Post {
+func (x *GetTrendingResponse) GetHashtags() []*TrendingHashtag {
 	if x != nil {
-		return x.Post
+		return x.Hashtags
 	}
 	return nil
 }
 
-type ListPostsByUserRequest struct {
+type SearchFilters struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`               // Filter by user
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`         // Number of posts per page
-	PagingState   []byte                 `protobuf:"bytes,3,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"` // Cassandra paging state (opaque cursor)
+	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`     // inclusive
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`     // exclusive
+	Hashtag       string                 `protobuf:"bytes,4,opt,name=hashtag,proto3" json:"hashtag,omitempty"` // with or without the leading '#'
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPostsByUserRequest) Reset() {
-	*x = ListPostsByUserRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[20]
+func (x *SearchFilters) Reset() {
+	*x = SearchFilters{}
+	mi := &file_posts_v1_post_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPostsByUserRequest) String() string {
+func (x *SearchFilters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostsByUserRequest) ProtoMessage() {}
+func (*SearchFilters) ProtoMessage() {}
 
-func (x *ListPostsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[20]
+func (x *SearchFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,55 +4093,65 @@ func (x *ListPostsByUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostsByUserRequest.ProtoReflect.Descriptor instead.
-func (*ListPostsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SearchFilters.ProtoReflect.Descriptor instead.
+func (*SearchFilters) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListPostsByUserRequest) GetUserId() int64 {
+func (x *SearchFilters) GetAuthorId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *ListPostsByUserRequest) GetPageSize() int32 {
+func (x *SearchFilters) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.Since
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPostsByUserRequest) GetPagingState() []byte {
+func (x *SearchFilters) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PagingState
+		return x.Until
 	}
 	return nil
 }
 
-type ListPostsByUserResponse struct {
+func (x *SearchFilters) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+type SearchPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	PagingState   []byte                 `protobuf:"bytes,2,opt,name=paging_state,json=pagingState,proto3" json:"paging_state,omitempty"` // Return for next page query
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // words must all match; "double quoted" words must match as a phrase
+	Filters       *SearchFilters         `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	Sort          SearchSort             `protobuf:"varint,3,opt,name=sort,proto3,enum=posts.v1.SearchSort" json:"sort,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        []byte                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPostsByUserResponse) Reset() {
-	*x = ListPostsByUserResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[21]
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPostsByUserResponse) String() string {
+func (x *SearchPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostsByUserResponse) ProtoMessage() {}
+func (*SearchPostsRequest) ProtoMessage() {}
 
-func (x *ListPostsByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[21]
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,47 +4162,70 @@ func (x *ListPostsByUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostsByUserResponse.ProtoReflect.Descriptor instead.
-func (*ListPostsByUserResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListPostsByUserResponse) GetPosts() []*Post {
+func (x *SearchPostsRequest) GetQuery() string {
 	if x != nil {
-		return x.Posts
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
 	}
 	return nil
 }
 
-func (x *ListPostsByUserResponse) GetPagingState() []byte {
+func (x *SearchPostsRequest) GetSort() SearchSort {
 	if x != nil {
-		return x.PagingState
+		return x.Sort
+	}
+	return SearchSort_SEARCH_SORT_UNSPECIFIED
+}
+
+func (x *SearchPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
 	}
 	return nil
 }
 
-type DeletePostRequest struct {
+type SearchPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Cursor        []byte                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                                                                            // empty when there are no more results
+	ViewerStates  map[int64]*ViewerState `protobuf:"bytes,3,rep,name=viewer_states,json=viewerStates,proto3" json:"viewer_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by post id, including embedded posts
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_posts_v1_post_proto_msgTypes[22]
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *SearchPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*SearchPostsResponse) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[22]
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,40 +4236,58 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *DeletePostRequest) GetPostId() int64 {
+func (x *SearchPostsResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.PostId
+		return x.Posts
 	}
-	return 0
+	return nil
 }
 
-type DeletePostResponse struct {
+func (x *SearchPostsResponse) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *SearchPostsResponse) GetViewerStates() map[int64]*ViewerState {
+	if x != nil {
+		return x.ViewerStates
+	}
+	return nil
+}
+
+// Reserves a media id and returns where to upload the file. The upload is an HTTP PUT
+// of the raw bytes to upload_url with the X-Upload-Token header set to upload_token and
+// Content-Type set to content_type.
+type CreateUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // image/jpeg, image/png or image/gif
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`      // upper bound on the uploaded size
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_posts_v1_post_proto_msgTypes[23]
+func (x *CreateUploadRequest) Reset() {
+	*x = CreateUploadRequest{}
+	mi := &file_posts_v1_post_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *CreateUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*CreateUploadRequest) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[23]
+func (x *CreateUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,43 +4298,50 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CreateUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DeletePostResponse) GetSuccess() bool {
+func (x *CreateUploadRequest) GetContentType() string {
 	if x != nil {
-		return x.Success
+		return x.ContentType
 	}
-	return false
+	return ""
 }
 
-type PostEngagements struct {
+func (x *CreateUploadRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type CreateUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LikeCount     int64                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	ShareCount    int64                  `protobuf:"varint,2,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
-	CommentCount  int64                  `protobuf:"varint,3,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	RepostCount   int64                  `protobuf:"varint,4,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	MediaId       int64                  `protobuf:"varint,1,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	UploadToken   string                 `protobuf:"bytes,3,opt,name=upload_token,json=uploadToken,proto3" json:"upload_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // the upload must finish before this
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PostEngagements) Reset() {
-	*x = PostEngagements{}
-	mi := &file_posts_v1_post_proto_msgTypes[24]
+func (x *CreateUploadResponse) Reset() {
+	*x = CreateUploadResponse{}
+	mi := &file_posts_v1_post_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostEngagements) String() string {
+func (x *CreateUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostEngagements) ProtoMessage() {}
+func (*CreateUploadResponse) ProtoMessage() {}
 
-func (x *PostEngagements) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_post_proto_msgTypes[24]
+func (x *CreateUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_post_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,51 +4352,72 @@ func (x *PostEngagements) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostEngagements.ProtoReflect.Descriptor instead.
-func (*PostEngagements) Descriptor() ([]byte, []int) {
-	return file_posts_v1_post_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateUploadResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_post_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *PostEngagements) GetLikeCount() int64 {
+func (x *CreateUploadResponse) GetMediaId() int64 {
 	if x != nil {
-		return x.LikeCount
+		return x.MediaId
 	}
 	return 0
 }
 
-func (x *PostEngagements) GetShareCount() int64 {
+func (x *CreateUploadResponse) GetUploadUrl() string {
 	if x != nil {
-		return x.ShareCount
+		return x.UploadUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *PostEngagements) GetCommentCount() int64 {
+func (x *CreateUploadResponse) GetUploadToken() string {
 	if x != nil {
-		return x.CommentCount
+		return x.UploadToken
 	}
-	return 0
+	return ""
 }
 
-func (x *PostEngagements) GetRepostCount() int64 {
+func (x *CreateUploadResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RepostCount
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
 var File_posts_v1_post_proto protoreflect.FileDescriptor
 
 const file_posts_v1_post_proto_rawDesc = "" +
 	"\n" +
-	"\x13posts/v1/post.proto\x12\bposts.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12user/v1/user.proto\"\xab\x01\n" +
+	"\x13posts/v1/post.proto\x12\bposts.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12user/v1/user.proto\"\x85\x05\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
 	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12!\n" +
 	"\x04user\x18\x04 \x01(\v2\r.user.v1.UserR\x04user\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x7f\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12.\n" +
+	"\baudience\x18\x06 \x01(\x0e2\x12.posts.v1.AudienceR\baudience\x12'\n" +
+	"\x10reply_to_post_id\x18\a \x01(\x03R\rreplyToPostId\x12 \n" +
+	"\froot_post_id\x18\b \x01(\x03R\n" +
+	"rootPostId\x12\"\n" +
+	"\rquote_post_id\x18\t \x01(\x03R\vquotePostId\x12)\n" +
+	"\x11repost_of_post_id\x18\n" +
+	" \x01(\x03R\x0erepostOfPostId\x123\n" +
+	"\rembedded_post\x18\v \x01(\v2\x0e.posts.v1.PostR\fembeddedPost\x127\n" +
+	"\tedited_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x1a\n" +
+	"\bhashtags\x18\r \x03(\tR\bhashtags\x12/\n" +
+	"\bentities\x18\x0e \x03(\v2\x13.user.v1.TextEntityR\bentities\x12,\n" +
+	"\x12mentioned_user_ids\x18\x0f \x03(\x03R\x10mentionedUserIds\x12%\n" +
+	"\x05media\x18\x10 \x03(\v2\x0f.posts.v1.MediaR\x05media\"\xbe\x01\n" +
+	"\x05Media\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x13.posts.v1.MediaTypeR\x04type\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x19\n" +
+	"\balt_text\x18\a \x01(\tR\aaltText\"\x7f\n" +
 	"\vOutboxEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -1327,25 +4431,115 @@ const file_posts_v1_post_proto_rawDesc = "" +
 	" InitializePostEngagementsRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\"7\n" +
 	"!InitializePostEngagementsResponse\x12\x12\n" +
-	"\x04true\x18\x01 \x01(\bR\x04true\"s\n" +
+	"\x04true\x18\x01 \x01(\bR\x04true\"\xae\x01\n" +
 	"\x04Like\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x129\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"E\n" +
 	"\x11CreateLikeRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\"8\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"R\n" +
 	"\x12CreateLikeResponse\x12\"\n" +
-	"\x04like\x18\x01 \x01(\v2\x0e.posts.v1.LikeR\x04like\"=\n" +
+	"\x04like\x18\x01 \x01(\v2\x0e.posts.v1.LikeR\x04like\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"=\n" +
 	"\x17CreateLikeByUserRequest\x12\"\n" +
 	"\x04like\x18\x01 \x01(\v2\x0e.posts.v1.LikeR\x04like\"4\n" +
 	"\x18CreateLikeByUserResponse\x12\x18\n" +
-	"\acreated\x18\x01 \x01(\bR\acreated\"4\n" +
+	"\acreated\x18\x01 \x01(\bR\acreated\"E\n" +
+	"\x11DeleteLikeRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\".\n" +
+	"\x12DeleteLikeResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"X\n" +
+	"\x17DeleteLikeByUserRequest\x12\"\n" +
+	"\x04like\x18\x01 \x01(\v2\x0e.posts.v1.LikeR\x04like\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"4\n" +
+	"\x18DeleteLikeByUserResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"q\n" +
+	"\x16ListLikesByPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
+	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"a\n" +
+	"\x17ListLikesByPostResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12!\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\"v\n" +
+	"\x1bListLikedPostsByUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
+	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"\x9e\x02\n" +
+	"\x1cListLikedPostsByUserResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12!\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\x12]\n" +
+	"\rviewer_states\x18\x03 \x03(\v28.posts.v1.ListLikedPostsByUserResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"O\n" +
 	"\x19IncrementPostLikesRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\">\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\">\n" +
 	"\x1aIncrementPostLikesResponse\x12 \n" +
-	"\vincremented\x18\x01 \x01(\bR\vincremented\"\xc9\x01\n" +
+	"\vincremented\x18\x01 \x01(\bR\vincremented\"S\n" +
+	"\x1dIncrementUserPostCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"B\n" +
+	"\x1eIncrementUserPostCountResponse\x12 \n" +
+	"\vincremented\x18\x01 \x01(\bR\vincremented\"k\n" +
+	"\x10GetThreadRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
+	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"\xde\x02\n" +
+	"\x11GetThreadResponse\x12,\n" +
+	"\tancestors\x18\x01 \x03(\v2\x0e.posts.v1.PostR\tancestors\x12\"\n" +
+	"\x04post\x18\x02 \x01(\v2\x0e.posts.v1.PostR\x04post\x12(\n" +
+	"\areplies\x18\x03 \x03(\v2\x0e.posts.v1.PostR\areplies\x12!\n" +
+	"\fpaging_state\x18\x04 \x01(\fR\vpagingState\x12R\n" +
+	"\rviewer_states\x18\x05 \x03(\v2-.posts.v1.GetThreadResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"m\n" +
+	"\x12ListRepliesRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
+	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"\x8c\x02\n" +
+	"\x13ListRepliesResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12!\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\x12T\n" +
+	"\rviewer_states\x18\x03 \x03(\v2/.posts.v1.ListRepliesResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"G\n" +
+	"\x1fCreateReplyIndexedByPostRequest\x12$\n" +
+	"\x05reply\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x05reply\"<\n" +
+	" CreateReplyIndexedByPostResponse\x12\x18\n" +
+	"\aindexed\x18\x01 \x01(\bR\aindexed\"\x92\x01\n" +
+	"\x06Repost\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\trepost_id\x18\x03 \x01(\x03R\brepostId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"(\n" +
+	"\rRepostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"T\n" +
+	"\x0eRepostResponse\x12(\n" +
+	"\x06repost\x18\x01 \x01(\v2\x10.posts.v1.RepostR\x06repost\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\",\n" +
+	"\x11UndoRepostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\".\n" +
+	"\x12UndoRepostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x1bIncrementPostRepostsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"@\n" +
+	"\x1cIncrementPostRepostsResponse\x12 \n" +
+	"\vincremented\x18\x01 \x01(\bR\vincremented\"Q\n" +
+	"\x1bDecrementPostRepostsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"@\n" +
+	"\x1cDecrementPostRepostsResponse\x12 \n" +
+	"\vdecremented\x18\x01 \x01(\bR\vdecremented\"\x83\x02\n" +
 	"\x1bGetPostWithMetadataResponse\x12\"\n" +
 	"\x04post\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x04post\x12\x1d\n" +
 	"\n" +
@@ -1353,15 +4547,26 @@ const file_posts_v1_post_proto_rawDesc = "" +
 	"\vshare_count\x18\x03 \x01(\x03R\n" +
 	"shareCount\x12#\n" +
 	"\rcomment_count\x18\x04 \x01(\x03R\fcommentCount\x12!\n" +
-	"\frepost_count\x18\x05 \x01(\x03R\vrepostCount\"7\n" +
+	"\frepost_count\x18\x05 \x01(\x03R\vrepostCount\x128\n" +
+	"\fviewer_state\x18\x06 \x01(\v2\x15.posts.v1.ViewerStateR\vviewerState\"?\n" +
+	"\vViewerState\x12\x14\n" +
+	"\x05liked\x18\x01 \x01(\bR\x05liked\x12\x1a\n" +
+	"\breposted\x18\x02 \x01(\bR\breposted\"7\n" +
 	"\x1cUpdatePostEngagementsRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\"9\n" +
 	"\x1dUpdatePostEngagementsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x91\x02\n" +
 	"\x11CreatePostRequest\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
 	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x03R\x06userId\"8\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12.\n" +
+	"\baudience\x18\x04 \x01(\x0e2\x12.posts.v1.AudienceR\baudience\x12'\n" +
+	"\x10reply_to_post_id\x18\x05 \x01(\x03R\rreplyToPostId\x12\"\n" +
+	"\rquote_post_id\x18\x06 \x01(\x03R\vquotePostId\x12/\n" +
+	"\x05media\x18\a \x03(\v2\x19.posts.v1.MediaAttachmentR\x05media\"G\n" +
+	"\x0fMediaAttachment\x12\x19\n" +
+	"\bmedia_id\x18\x01 \x01(\x03R\amediaId\x12\x19\n" +
+	"\balt_text\x18\x02 \x01(\tR\aaltText\"8\n" +
 	"\x12CreatePostResponse\x12\"\n" +
 	"\x04post\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x04post\")\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
@@ -1371,10 +4576,14 @@ const file_posts_v1_post_proto_rawDesc = "" +
 	"\x16ListPostsByUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
-	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"b\n" +
+	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"\x94\x02\n" +
 	"\x17ListPostsByUserResponse\x12$\n" +
 	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12!\n" +
-	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\",\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\x12X\n" +
+	"\rviewer_states\x18\x03 \x03(\v23.posts.v1.ListPostsByUserResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\",\n" +
 	"\x11DeletePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\".\n" +
 	"\x12DeletePostResponse\x12\x18\n" +
@@ -1385,10 +4594,130 @@ const file_posts_v1_post_proto_rawDesc = "" +
 	"\vshare_count\x18\x02 \x01(\x03R\n" +
 	"shareCount\x12#\n" +
 	"\rcomment_count\x18\x03 \x01(\x03R\fcommentCount\x12!\n" +
-	"\frepost_count\x18\x04 \x01(\x03R\vrepostCount2\xe4\a\n" +
+	"\frepost_count\x18\x04 \x01(\x03R\vrepostCount\"X\n" +
+	"\x16GetHomeTimelineRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12!\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\"\x94\x02\n" +
+	"\x17GetHomeTimelineResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12!\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\x12X\n" +
+	"\rviewer_states\x18\x03 \x03(\v23.posts.v1.GetHomeTimelineResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"W\n" +
+	"\x19GetRecommendedFeedRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x96\x02\n" +
+	"\x1aGetRecommendedFeedResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12[\n" +
+	"\rviewer_states\x18\x03 \x03(\v26.posts.v1.GetRecommendedFeedResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"a\n" +
+	"\x0fEditPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\"6\n" +
+	"\x10EditPostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x04post\"\xd6\x01\n" +
+	"\fPostRevision\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreplaced_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replacedAt\"s\n" +
+	"\x18ListPostRevisionsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
+	"\fpaging_state\x18\x03 \x01(\fR\vpagingState\"t\n" +
+	"\x19ListPostRevisionsResponse\x124\n" +
+	"\trevisions\x18\x01 \x03(\v2\x16.posts.v1.PostRevisionR\trevisions\x12!\n" +
+	"\fpaging_state\x18\x02 \x01(\fR\vpagingState\"S\n" +
+	"\x1dDecrementUserPostCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"B\n" +
+	"\x1eDecrementUserPostCountResponse\x12 \n" +
+	"\vdecremented\x18\x01 \x01(\bR\vdecremented\"b\n" +
+	"\x19ListPostsByHashtagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\fR\x06cursor\"\x8f\x02\n" +
+	"\x1aListPostsByHashtagResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\fR\x06cursor\x12[\n" +
+	"\rviewer_states\x18\x03 \x03(\v26.posts.v1.ListPostsByHashtagResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"\xad\x01\n" +
+	"\x0eMentionedEvent\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12*\n" +
+	"\x11mentioned_user_id\x18\x03 \x01(\x03R\x0fmentionedUserId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"9\n" +
+	"\x0fTrendingHashtag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"*\n" +
+	"\x12GetTrendingRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"L\n" +
+	"\x13GetTrendingResponse\x125\n" +
+	"\bhashtags\x18\x01 \x03(\v2\x19.posts.v1.TrendingHashtagR\bhashtags\"\xaa\x01\n" +
+	"\rSearchFilters\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x18\n" +
+	"\ahashtag\x18\x04 \x01(\tR\ahashtag\"\xbc\x01\n" +
+	"\x12SearchPostsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x121\n" +
+	"\afilters\x18\x02 \x01(\v2\x17.posts.v1.SearchFiltersR\afilters\x12(\n" +
+	"\x04sort\x18\x03 \x01(\x0e2\x14.posts.v1.SearchSortR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\fR\x06cursor\"\x81\x02\n" +
+	"\x13SearchPostsResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\fR\x06cursor\x12T\n" +
+	"\rviewer_states\x18\x03 \x03(\v2/.posts.v1.SearchPostsResponse.ViewerStatesEntryR\fviewerStates\x1aV\n" +
+	"\x11ViewerStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.posts.v1.ViewerStateR\x05value:\x028\x01\"W\n" +
+	"\x13CreateUploadRequest\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\"\xae\x01\n" +
+	"\x14CreateUploadResponse\x12\x19\n" +
+	"\bmedia_id\x18\x01 \x01(\x03R\amediaId\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12!\n" +
+	"\fupload_token\x18\x03 \x01(\tR\vuploadToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*U\n" +
+	"\bAudience\x12\x18\n" +
+	"\x14AUDIENCE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fAUDIENCE_PUBLIC\x10\x01\x12\x1a\n" +
+	"\x16AUDIENCE_CLOSE_FRIENDS\x10\x02*=\n" +
+	"\tMediaType\x12\x1a\n" +
+	"\x16MEDIA_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10MEDIA_TYPE_IMAGE\x10\x01*]\n" +
+	"\n" +
+	"SearchSort\x12\x1b\n" +
+	"\x17SEARCH_SORT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SEARCH_SORT_RELEVANCE\x10\x01\x12\x17\n" +
+	"\x13SEARCH_SORT_RECENCY\x10\x022\x9c\x16\n" +
 	"\vPostService\x12G\n" +
 	"\n" +
-	"CreateLike\x12\x1b.posts.v1.CreateLikeRequest\x1a\x1c.posts.v1.CreateLikeResponse\x12_\n" +
+	"CreateLike\x12\x1b.posts.v1.CreateLikeRequest\x1a\x1c.posts.v1.CreateLikeResponse\x12G\n" +
+	"\n" +
+	"DeleteLike\x12\x1b.posts.v1.DeleteLikeRequest\x1a\x1c.posts.v1.DeleteLikeResponse\x12Y\n" +
+	"\x10DeleteLikeByUser\x12!.posts.v1.DeleteLikeByUserRequest\x1a\".posts.v1.DeleteLikeByUserResponse\x12V\n" +
+	"\x0fListLikesByPost\x12 .posts.v1.ListLikesByPostRequest\x1a!.posts.v1.ListLikesByPostResponse\x12e\n" +
+	"\x14ListLikedPostsByUser\x12%.posts.v1.ListLikedPostsByUserRequest\x1a&.posts.v1.ListLikedPostsByUserResponse\x12V\n" +
+	"\x0fGetHomeTimeline\x12 .posts.v1.GetHomeTimelineRequest\x1a!.posts.v1.GetHomeTimelineResponse\x12_\n" +
+	"\x12GetRecommendedFeed\x12#.posts.v1.GetRecommendedFeedRequest\x1a$.posts.v1.GetRecommendedFeedResponse\x12_\n" +
 	"\x12IncrementPostLikes\x12#.posts.v1.IncrementPostLikesRequest\x1a$.posts.v1.IncrementPostLikesResponse\x12Y\n" +
 	"\x10CreateLikeByUser\x12!.posts.v1.CreateLikeByUserRequest\x1a\".posts.v1.CreateLikeByUserResponse\x12G\n" +
 	"\n" +
@@ -1400,7 +4729,23 @@ const file_posts_v1_post_proto_rawDesc = "" +
 	"\x17CreatePostIndexedByUser\x12(.posts.v1.CreatePostIndexedByUserRequest\x1a).posts.v1.CreatePostIndexedByUserResponse\x12t\n" +
 	"\x19InitializePostEngagements\x12*.posts.v1.InitializePostEngagementsRequest\x1a+.posts.v1.InitializePostEngagementsResponse\x12h\n" +
 	"\x15UpdatePostEngagements\x12&.posts.v1.UpdatePostEngagementsRequest\x1a'.posts.v1.UpdatePostEngagementsResponse\x12V\n" +
-	"\x13GetPostWithMetadata\x12\x18.posts.v1.GetPostRequest\x1a%.posts.v1.GetPostWithMetadataResponseB\x9b\x01\n" +
+	"\x13GetPostWithMetadata\x12\x18.posts.v1.GetPostRequest\x1a%.posts.v1.GetPostWithMetadataResponse\x12k\n" +
+	"\x16IncrementUserPostCount\x12'.posts.v1.IncrementUserPostCountRequest\x1a(.posts.v1.IncrementUserPostCountResponse\x12D\n" +
+	"\tGetThread\x12\x1a.posts.v1.GetThreadRequest\x1a\x1b.posts.v1.GetThreadResponse\x12J\n" +
+	"\vListReplies\x12\x1c.posts.v1.ListRepliesRequest\x1a\x1d.posts.v1.ListRepliesResponse\x12q\n" +
+	"\x18CreateReplyIndexedByPost\x12).posts.v1.CreateReplyIndexedByPostRequest\x1a*.posts.v1.CreateReplyIndexedByPostResponse\x12;\n" +
+	"\x06Repost\x12\x17.posts.v1.RepostRequest\x1a\x18.posts.v1.RepostResponse\x12G\n" +
+	"\n" +
+	"UndoRepost\x12\x1b.posts.v1.UndoRepostRequest\x1a\x1c.posts.v1.UndoRepostResponse\x12e\n" +
+	"\x14IncrementPostReposts\x12%.posts.v1.IncrementPostRepostsRequest\x1a&.posts.v1.IncrementPostRepostsResponse\x12e\n" +
+	"\x14DecrementPostReposts\x12%.posts.v1.DecrementPostRepostsRequest\x1a&.posts.v1.DecrementPostRepostsResponse\x12A\n" +
+	"\bEditPost\x12\x19.posts.v1.EditPostRequest\x1a\x1a.posts.v1.EditPostResponse\x12\\\n" +
+	"\x11ListPostRevisions\x12\".posts.v1.ListPostRevisionsRequest\x1a#.posts.v1.ListPostRevisionsResponse\x12k\n" +
+	"\x16DecrementUserPostCount\x12'.posts.v1.DecrementUserPostCountRequest\x1a(.posts.v1.DecrementUserPostCountResponse\x12_\n" +
+	"\x12ListPostsByHashtag\x12#.posts.v1.ListPostsByHashtagRequest\x1a$.posts.v1.ListPostsByHashtagResponse\x12J\n" +
+	"\vGetTrending\x12\x1c.posts.v1.GetTrendingRequest\x1a\x1d.posts.v1.GetTrendingResponse\x12J\n" +
+	"\vSearchPosts\x12\x1c.posts.v1.SearchPostsRequest\x1a\x1d.posts.v1.SearchPostsResponse\x12M\n" +
+	"\fCreateUpload\x12\x1d.posts.v1.CreateUploadRequest\x1a\x1e.posts.v1.CreateUploadResponseB\x9b\x01\n" +
 	"\fcom.posts.v1B\tPostProtoP\x01Z?github.com/yaninyzwitty/threads-go-backend/gen/posts/v1;postsv1\xa2\x02\x03PXX\xaa\x02\bPosts.V1\xca\x02\bPosts\\V1\xe2\x02\x14Posts\\V1\\GPBMetadata\xea\x02\tPosts::V1b\x06proto3"
 
 var (
@@ -1415,74 +4760,230 @@ func file_posts_v1_post_proto_rawDescGZIP() []byte {
 	return file_posts_v1_post_proto_rawDescData
 }
 
-var file_posts_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_posts_v1_post_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_posts_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_posts_v1_post_proto_goTypes = []any{
-	(*Post)(nil),                              // 0: posts.v1.Post
-	(*OutboxEvent)(nil),                       // 1: posts.v1.OutboxEvent
-	(*CreatePostIndexedByUserRequest)(nil),    // 2: posts.v1.CreatePostIndexedByUserRequest
-	(*CreatePostIndexedByUserResponse)(nil),   // 3: posts.v1.CreatePostIndexedByUserResponse
-	(*InitializePostEngagementsRequest)(nil),  // 4: posts.v1.InitializePostEngagementsRequest
-	(*InitializePostEngagementsResponse)(nil), // 5: posts.v1.InitializePostEngagementsResponse
-	(*Like)(nil),                              // 6: posts.v1.Like
-	(*CreateLikeRequest)(nil),                 // 7: posts.v1.CreateLikeRequest
-	(*CreateLikeResponse)(nil),                // 8: posts.v1.CreateLikeResponse
-	(*CreateLikeByUserRequest)(nil),           // 9: posts.v1.CreateLikeByUserRequest
-	(*CreateLikeByUserResponse)(nil),          // 10: posts.v1.CreateLikeByUserResponse
-	(*IncrementPostLikesRequest)(nil),         // 11: posts.v1.IncrementPostLikesRequest
-	(*IncrementPostLikesResponse)(nil),        // 12: posts.v1.IncrementPostLikesResponse
-	(*GetPostWithMetadataResponse)(nil),       // 13: posts.v1.GetPostWithMetadataResponse
-	(*UpdatePostEngagementsRequest)(nil),      // 14: posts.v1.UpdatePostEngagementsRequest
-	(*UpdatePostEngagementsResponse)(nil),     // 15: posts.v1.UpdatePostEngagementsResponse
-	(*CreatePostRequest)(nil),                 // 16: posts.v1.CreatePostRequest
-	(*CreatePostResponse)(nil),                // 17: posts.v1.CreatePostResponse
-	(*GetPostRequest)(nil),                    // 18: posts.v1.GetPostRequest
-	(*GetPostResponse)(nil),                   // 19: posts.v1.GetPostResponse
-	(*ListPostsByUserRequest)(nil),            // 20: posts.v1.ListPostsByUserRequest
-	(*ListPostsByUserResponse)(nil),           // 21: posts.v1.ListPostsByUserResponse
-	(*DeletePostRequest)(nil),                 // 22: posts.v1.DeletePostRequest
-	(*DeletePostResponse)(nil),                // 23: posts.v1.DeletePostResponse
-	(*PostEngagements)(nil),                   // 24: posts.v1.PostEngagements
-	(*v1.User)(nil),                           // 25: user.v1.User
-	(*timestamppb.Timestamp)(nil),             // 26: google.protobuf.Timestamp
+	(Audience)(0),                             // 0: posts.v1.Audience
+	(MediaType)(0),                            // 1: posts.v1.MediaType
+	(SearchSort)(0),                           // 2: posts.v1.SearchSort
+	(*Post)(nil),                              // 3: posts.v1.Post
+	(*Media)(nil),                             // 4: posts.v1.Media
+	(*OutboxEvent)(nil),                       // 5: posts.v1.OutboxEvent
+	(*CreatePostIndexedByUserRequest)(nil),    // 6: posts.v1.CreatePostIndexedByUserRequest
+	(*CreatePostIndexedByUserResponse)(nil),   // 7: posts.v1.CreatePostIndexedByUserResponse
+	(*InitializePostEngagementsRequest)(nil),  // 8: posts.v1.InitializePostEngagementsRequest
+	(*InitializePostEngagementsResponse)(nil), // 9: posts.v1.InitializePostEngagementsResponse
+	(*Like)(nil),                              // 10: posts.v1.Like
+	(*CreateLikeRequest)(nil),                 // 11: posts.v1.CreateLikeRequest
+	(*CreateLikeResponse)(nil),                // 12: posts.v1.CreateLikeResponse
+	(*CreateLikeByUserRequest)(nil),           // 13: posts.v1.CreateLikeByUserRequest
+	(*CreateLikeByUserResponse)(nil),          // 14: posts.v1.CreateLikeByUserResponse
+	(*DeleteLikeRequest)(nil),                 // 15: posts.v1.DeleteLikeRequest
+	(*DeleteLikeResponse)(nil),                // 16: posts.v1.DeleteLikeResponse
+	(*DeleteLikeByUserRequest)(nil),           // 17: posts.v1.DeleteLikeByUserRequest
+	(*DeleteLikeByUserResponse)(nil),          // 18: posts.v1.DeleteLikeByUserResponse
+	(*ListLikesByPostRequest)(nil),            // 19: posts.v1.ListLikesByPostRequest
+	(*ListLikesByPostResponse)(nil),           // 20: posts.v1.ListLikesByPostResponse
+	(*ListLikedPostsByUserRequest)(nil),       // 21: posts.v1.ListLikedPostsByUserRequest
+	(*ListLikedPostsByUserResponse)(nil),      // 22: posts.v1.ListLikedPostsByUserResponse
+	(*IncrementPostLikesRequest)(nil),         // 23: posts.v1.IncrementPostLikesRequest
+	(*IncrementPostLikesResponse)(nil),        // 24: posts.v1.IncrementPostLikesResponse
+	(*IncrementUserPostCountRequest)(nil),     // 25: posts.v1.IncrementUserPostCountRequest
+	(*IncrementUserPostCountResponse)(nil),    // 26: posts.v1.IncrementUserPostCountResponse
+	(*GetThreadRequest)(nil),                  // 27: posts.v1.GetThreadRequest
+	(*GetThreadResponse)(nil),                 // 28: posts.v1.GetThreadResponse
+	(*ListRepliesRequest)(nil),                // 29: posts.v1.ListRepliesRequest
+	(*ListRepliesResponse)(nil),               // 30: posts.v1.ListRepliesResponse
+	(*CreateReplyIndexedByPostRequest)(nil),   // 31: posts.v1.CreateReplyIndexedByPostRequest
+	(*CreateReplyIndexedByPostResponse)(nil),  // 32: posts.v1.CreateReplyIndexedByPostResponse
+	(*Repost)(nil),                            // 33: posts.v1.Repost
+	(*RepostRequest)(nil),                     // 34: posts.v1.RepostRequest
+	(*RepostResponse)(nil),                    // 35: posts.v1.RepostResponse
+	(*UndoRepostRequest)(nil),                 // 36: posts.v1.UndoRepostRequest
+	(*UndoRepostResponse)(nil),                // 37: posts.v1.UndoRepostResponse
+	(*IncrementPostRepostsRequest)(nil),       // 38: posts.v1.IncrementPostRepostsRequest
+	(*IncrementPostRepostsResponse)(nil),      // 39: posts.v1.IncrementPostRepostsResponse
+	(*DecrementPostRepostsRequest)(nil),       // 40: posts.v1.DecrementPostRepostsRequest
+	(*DecrementPostRepostsResponse)(nil),      // 41: posts.v1.DecrementPostRepostsResponse
+	(*GetPostWithMetadataResponse)(nil),       // 42: posts.v1.GetPostWithMetadataResponse
+	(*ViewerState)(nil),                       // 43: posts.v1.ViewerState
+	(*UpdatePostEngagementsRequest)(nil),      // 44: posts.v1.UpdatePostEngagementsRequest
+	(*UpdatePostEngagementsResponse)(nil),     // 45: posts.v1.UpdatePostEngagementsResponse
+	(*CreatePostRequest)(nil),                 // 46: posts.v1.CreatePostRequest
+	(*MediaAttachment)(nil),                   // 47: posts.v1.MediaAttachment
+	(*CreatePostResponse)(nil),                // 48: posts.v1.CreatePostResponse
+	(*GetPostRequest)(nil),                    // 49: posts.v1.GetPostRequest
+	(*GetPostResponse)(nil),                   // 50: posts.v1.GetPostResponse
+	(*ListPostsByUserRequest)(nil),            // 51: posts.v1.ListPostsByUserRequest
+	(*ListPostsByUserResponse)(nil),           // 52: posts.v1.ListPostsByUserResponse
+	(*DeletePostRequest)(nil),                 // 53: posts.v1.DeletePostRequest
+	(*DeletePostResponse)(nil),                // 54: posts.v1.DeletePostResponse
+	(*PostEngagements)(nil),                   // 55: posts.v1.PostEngagements
+	(*GetHomeTimelineRequest)(nil),            // 56: posts.v1.GetHomeTimelineRequest
+	(*GetHomeTimelineResponse)(nil),           // 57: posts.v1.GetHomeTimelineResponse
+	(*GetRecommendedFeedRequest)(nil),         // 58: posts.v1.GetRecommendedFeedRequest
+	(*GetRecommendedFeedResponse)(nil),        // 59: posts.v1.GetRecommendedFeedResponse
+	(*EditPostRequest)(nil),                   // 60: posts.v1.EditPostRequest
+	(*EditPostResponse)(nil),                  // 61: posts.v1.EditPostResponse
+	(*PostRevision)(nil),                      // 62: posts.v1.PostRevision
+	(*ListPostRevisionsRequest)(nil),          // 63: posts.v1.ListPostRevisionsRequest
+	(*ListPostRevisionsResponse)(nil),         // 64: posts.v1.ListPostRevisionsResponse
+	(*DecrementUserPostCountRequest)(nil),     // 65: posts.v1.DecrementUserPostCountRequest
+	(*DecrementUserPostCountResponse)(nil),    // 66: posts.v1.DecrementUserPostCountResponse
+	(*ListPostsByHashtagRequest)(nil),         // 67: posts.v1.ListPostsByHashtagRequest
+	(*ListPostsByHashtagResponse)(nil),        // 68: posts.v1.ListPostsByHashtagResponse
+	(*MentionedEvent)(nil),                    // 69: posts.v1.MentionedEvent
+	(*TrendingHashtag)(nil),                   // 70: posts.v1.TrendingHashtag
+	(*GetTrendingRequest)(nil),                // 71: posts.v1.GetTrendingRequest
+	(*GetTrendingResponse)(nil),               // 72: posts.v1.GetTrendingResponse
+	(*SearchFilters)(nil),                     // 73: posts.v1.SearchFilters
+	(*SearchPostsRequest)(nil),                // 74: posts.v1.SearchPostsRequest
+	(*SearchPostsResponse)(nil),               // 75: posts.v1.SearchPostsResponse
+	(*CreateUploadRequest)(nil),               // 76: posts.v1.CreateUploadRequest
+	(*CreateUploadResponse)(nil),              // 77: posts.v1.CreateUploadResponse
+	nil,                                       // 78: posts.v1.ListLikedPostsByUserResponse.ViewerStatesEntry
+	nil,                                       // 79: posts.v1.GetThreadResponse.ViewerStatesEntry
+	nil,                                       // 80: posts.v1.ListRepliesResponse.ViewerStatesEntry
+	nil,                                       // 81: posts.v1.ListPostsByUserResponse.ViewerStatesEntry
+	nil,                                       // 82: posts.v1.GetHomeTimelineResponse.ViewerStatesEntry
+	nil,                                       // 83: posts.v1.GetRecommendedFeedResponse.ViewerStatesEntry
+	nil,                                       // 84: posts.v1.ListPostsByHashtagResponse.ViewerStatesEntry
+	nil,                                       // 85: posts.v1.SearchPostsResponse.ViewerStatesEntry
+	(*v1.User)(nil),                           // 86: user.v1.User
+	(*timestamppb.Timestamp)(nil),             // 87: google.protobuf.Timestamp
+	(*v1.TextEntity)(nil),                     // 88: user.v1.TextEntity
 }
 var file_posts_v1_post_proto_depIdxs = []int32{
-	25, // 0: posts.v1.Post.user:type_name -> user.v1.User
-	26, // 1: posts.v1.Post.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: posts.v1.CreatePostIndexedByUserRequest.post:type_name -> posts.v1.Post
-	26, // 3: posts.v1.Like.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 4: posts.v1.CreateLikeResponse.like:type_name -> posts.v1.Like
-	6,  // 5: posts.v1.CreateLikeByUserRequest.like:type_name -> posts.v1.Like
-	0,  // 6: posts.v1.GetPostWithMetadataResponse.post:type_name -> posts.v1.Post
-	0,  // 7: posts.v1.CreatePostResponse.post:type_name -> posts.v1.Post
-	0,  // 8: posts.v1.GetPostResponse.post:type_name -> posts.v1.Post
-	0,  // 9: posts.v1.ListPostsByUserResponse.posts:type_name -> posts.v1.Post
-	7,  // 10: posts.v1.PostService.CreateLike:input_type -> posts.v1.CreateLikeRequest
-	11, // 11: posts.v1.PostService.IncrementPostLikes:input_type -> posts.v1.IncrementPostLikesRequest
-	9,  // 12: posts.v1.PostService.CreateLikeByUser:input_type -> posts.v1.CreateLikeByUserRequest
-	16, // 13: posts.v1.PostService.CreatePost:input_type -> posts.v1.CreatePostRequest
-	18, // 14: posts.v1.PostService.GetPost:input_type -> posts.v1.GetPostRequest
-	20, // 15: posts.v1.PostService.ListPostsByUser:input_type -> posts.v1.ListPostsByUserRequest
-	22, // 16: posts.v1.PostService.DeletePost:input_type -> posts.v1.DeletePostRequest
-	2,  // 17: posts.v1.PostService.CreatePostIndexedByUser:input_type -> posts.v1.CreatePostIndexedByUserRequest
-	4,  // 18: posts.v1.PostService.InitializePostEngagements:input_type -> posts.v1.InitializePostEngagementsRequest
-	14, // 19: posts.v1.PostService.UpdatePostEngagements:input_type -> posts.v1.UpdatePostEngagementsRequest
-	18, // 20: posts.v1.PostService.GetPostWithMetadata:input_type -> posts.v1.GetPostRequest
-	8,  // 21: posts.v1.PostService.CreateLike:output_type -> posts.v1.CreateLikeResponse
-	12, // 22: posts.v1.PostService.IncrementPostLikes:output_type -> posts.v1.IncrementPostLikesResponse
-	10, // 23: posts.v1.PostService.CreateLikeByUser:output_type -> posts.v1.CreateLikeByUserResponse
-	17, // 24: posts.v1.PostService.CreatePost:output_type -> posts.v1.CreatePostResponse
-	19, // 25: posts.v1.PostService.GetPost:output_type -> posts.v1.GetPostResponse
-	21, // 26: posts.v1.PostService.ListPostsByUser:output_type -> posts.v1.ListPostsByUserResponse
-	23, // 27: posts.v1.PostService.DeletePost:output_type -> posts.v1.DeletePostResponse
-	3,  // 28: posts.v1.PostService.CreatePostIndexedByUser:output_type -> posts.v1.CreatePostIndexedByUserResponse
-	5,  // 29: posts.v1.PostService.InitializePostEngagements:output_type -> posts.v1.InitializePostEngagementsResponse
-	15, // 30: posts.v1.PostService.UpdatePostEngagements:output_type -> posts.v1.UpdatePostEngagementsResponse
-	13, // 31: posts.v1.PostService.GetPostWithMetadata:output_type -> posts.v1.GetPostWithMetadataResponse
-	21, // [21:32] is the sub-list for method output_type
-	10, // [10:21] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	86, // 0: posts.v1.Post.user:type_name -> user.v1.User
+	87, // 1: posts.v1.Post.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: posts.v1.Post.audience:type_name -> posts.v1.Audience
+	3,  // 3: posts.v1.Post.embedded_post:type_name -> posts.v1.Post
+	87, // 4: posts.v1.Post.edited_at:type_name -> google.protobuf.Timestamp
+	88, // 5: posts.v1.Post.entities:type_name -> user.v1.TextEntity
+	4,  // 6: posts.v1.Post.media:type_name -> posts.v1.Media
+	1,  // 7: posts.v1.Media.type:type_name -> posts.v1.MediaType
+	3,  // 8: posts.v1.CreatePostIndexedByUserRequest.post:type_name -> posts.v1.Post
+	87, // 9: posts.v1.Like.created_at:type_name -> google.protobuf.Timestamp
+	87, // 10: posts.v1.Like.deleted_at:type_name -> google.protobuf.Timestamp
+	10, // 11: posts.v1.CreateLikeResponse.like:type_name -> posts.v1.Like
+	10, // 12: posts.v1.CreateLikeByUserRequest.like:type_name -> posts.v1.Like
+	10, // 13: posts.v1.DeleteLikeByUserRequest.like:type_name -> posts.v1.Like
+	86, // 14: posts.v1.ListLikesByPostResponse.users:type_name -> user.v1.User
+	3,  // 15: posts.v1.ListLikedPostsByUserResponse.posts:type_name -> posts.v1.Post
+	78, // 16: posts.v1.ListLikedPostsByUserResponse.viewer_states:type_name -> posts.v1.ListLikedPostsByUserResponse.ViewerStatesEntry
+	3,  // 17: posts.v1.GetThreadResponse.ancestors:type_name -> posts.v1.Post
+	3,  // 18: posts.v1.GetThreadResponse.post:type_name -> posts.v1.Post
+	3,  // 19: posts.v1.GetThreadResponse.replies:type_name -> posts.v1.Post
+	79, // 20: posts.v1.GetThreadResponse.viewer_states:type_name -> posts.v1.GetThreadResponse.ViewerStatesEntry
+	3,  // 21: posts.v1.ListRepliesResponse.posts:type_name -> posts.v1.Post
+	80, // 22: posts.v1.ListRepliesResponse.viewer_states:type_name -> posts.v1.ListRepliesResponse.ViewerStatesEntry
+	3,  // 23: posts.v1.CreateReplyIndexedByPostRequest.reply:type_name -> posts.v1.Post
+	87, // 24: posts.v1.Repost.created_at:type_name -> google.protobuf.Timestamp
+	33, // 25: posts.v1.RepostResponse.repost:type_name -> posts.v1.Repost
+	3,  // 26: posts.v1.GetPostWithMetadataResponse.post:type_name -> posts.v1.Post
+	43, // 27: posts.v1.GetPostWithMetadataResponse.viewer_state:type_name -> posts.v1.ViewerState
+	0,  // 28: posts.v1.CreatePostRequest.audience:type_name -> posts.v1.Audience
+	47, // 29: posts.v1.CreatePostRequest.media:type_name -> posts.v1.MediaAttachment
+	3,  // 30: posts.v1.CreatePostResponse.post:type_name -> posts.v1.Post
+	3,  // 31: posts.v1.GetPostResponse.post:type_name -> posts.v1.Post
+	3,  // 32: posts.v1.ListPostsByUserResponse.posts:type_name -> posts.v1.Post
+	81, // 33: posts.v1.ListPostsByUserResponse.viewer_states:type_name -> posts.v1.ListPostsByUserResponse.ViewerStatesEntry
+	3,  // 34: posts.v1.GetHomeTimelineResponse.posts:type_name -> posts.v1.Post
+	82, // 35: posts.v1.GetHomeTimelineResponse.viewer_states:type_name -> posts.v1.GetHomeTimelineResponse.ViewerStatesEntry
+	3,  // 36: posts.v1.GetRecommendedFeedResponse.posts:type_name -> posts.v1.Post
+	83, // 37: posts.v1.GetRecommendedFeedResponse.viewer_states:type_name -> posts.v1.GetRecommendedFeedResponse.ViewerStatesEntry
+	3,  // 38: posts.v1.EditPostResponse.post:type_name -> posts.v1.Post
+	87, // 39: posts.v1.PostRevision.created_at:type_name -> google.protobuf.Timestamp
+	87, // 40: posts.v1.PostRevision.replaced_at:type_name -> google.protobuf.Timestamp
+	62, // 41: posts.v1.ListPostRevisionsResponse.revisions:type_name -> posts.v1.PostRevision
+	3,  // 42: posts.v1.ListPostsByHashtagResponse.posts:type_name -> posts.v1.Post
+	84, // 43: posts.v1.ListPostsByHashtagResponse.viewer_states:type_name -> posts.v1.ListPostsByHashtagResponse.ViewerStatesEntry
+	87, // 44: posts.v1.MentionedEvent.created_at:type_name -> google.protobuf.Timestamp
+	70, // 45: posts.v1.GetTrendingResponse.hashtags:type_name -> posts.v1.TrendingHashtag
+	87, // 46: posts.v1.SearchFilters.since:type_name -> google.protobuf.Timestamp
+	87, // 47: posts.v1.SearchFilters.until:type_name -> google.protobuf.Timestamp
+	73, // 48: posts.v1.SearchPostsRequest.filters:type_name -> posts.v1.SearchFilters
+	2,  // 49: posts.v1.SearchPostsRequest.sort:type_name -> posts.v1.SearchSort
+	3,  // 50: posts.v1.SearchPostsResponse.posts:type_name -> posts.v1.Post
+	85, // 51: posts.v1.SearchPostsResponse.viewer_states:type_name -> posts.v1.SearchPostsResponse.ViewerStatesEntry
+	87, // 52: posts.v1.CreateUploadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	43, // 53: posts.v1.ListLikedPostsByUserResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 54: posts.v1.GetThreadResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 55: posts.v1.ListRepliesResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 56: posts.v1.ListPostsByUserResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 57: posts.v1.GetHomeTimelineResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 58: posts.v1.GetRecommendedFeedResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 59: posts.v1.ListPostsByHashtagResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	43, // 60: posts.v1.SearchPostsResponse.ViewerStatesEntry.value:type_name -> posts.v1.ViewerState
+	11, // 61: posts.v1.PostService.CreateLike:input_type -> posts.v1.CreateLikeRequest
+	15, // 62: posts.v1.PostService.DeleteLike:input_type -> posts.v1.DeleteLikeRequest
+	17, // 63: posts.v1.PostService.DeleteLikeByUser:input_type -> posts.v1.DeleteLikeByUserRequest
+	19, // 64: posts.v1.PostService.ListLikesByPost:input_type -> posts.v1.ListLikesByPostRequest
+	21, // 65: posts.v1.PostService.ListLikedPostsByUser:input_type -> posts.v1.ListLikedPostsByUserRequest
+	56, // 66: posts.v1.PostService.GetHomeTimeline:input_type -> posts.v1.GetHomeTimelineRequest
+	58, // 67: posts.v1.PostService.GetRecommendedFeed:input_type -> posts.v1.GetRecommendedFeedRequest
+	23, // 68: posts.v1.PostService.IncrementPostLikes:input_type -> posts.v1.IncrementPostLikesRequest
+	13, // 69: posts.v1.PostService.CreateLikeByUser:input_type -> posts.v1.CreateLikeByUserRequest
+	46, // 70: posts.v1.PostService.CreatePost:input_type -> posts.v1.CreatePostRequest
+	49, // 71: posts.v1.PostService.GetPost:input_type -> posts.v1.GetPostRequest
+	51, // 72: posts.v1.PostService.ListPostsByUser:input_type -> posts.v1.ListPostsByUserRequest
+	53, // 73: posts.v1.PostService.DeletePost:input_type -> posts.v1.DeletePostRequest
+	6,  // 74: posts.v1.PostService.CreatePostIndexedByUser:input_type -> posts.v1.CreatePostIndexedByUserRequest
+	8,  // 75: posts.v1.PostService.InitializePostEngagements:input_type -> posts.v1.InitializePostEngagementsRequest
+	44, // 76: posts.v1.PostService.UpdatePostEngagements:input_type -> posts.v1.UpdatePostEngagementsRequest
+	49, // 77: posts.v1.PostService.GetPostWithMetadata:input_type -> posts.v1.GetPostRequest
+	25, // 78: posts.v1.PostService.IncrementUserPostCount:input_type -> posts.v1.IncrementUserPostCountRequest
+	27, // 79: posts.v1.PostService.GetThread:input_type -> posts.v1.GetThreadRequest
+	29, // 80: posts.v1.PostService.ListReplies:input_type -> posts.v1.ListRepliesRequest
+	31, // 81: posts.v1.PostService.CreateReplyIndexedByPost:input_type -> posts.v1.CreateReplyIndexedByPostRequest
+	34, // 82: posts.v1.PostService.Repost:input_type -> posts.v1.RepostRequest
+	36, // 83: posts.v1.PostService.UndoRepost:input_type -> posts.v1.UndoRepostRequest
+	38, // 84: posts.v1.PostService.IncrementPostReposts:input_type -> posts.v1.IncrementPostRepostsRequest
+	40, // 85: posts.v1.PostService.DecrementPostReposts:input_type -> posts.v1.DecrementPostRepostsRequest
+	60, // 86: posts.v1.PostService.EditPost:input_type -> posts.v1.EditPostRequest
+	63, // 87: posts.v1.PostService.ListPostRevisions:input_type -> posts.v1.ListPostRevisionsRequest
+	65, // 88: posts.v1.PostService.DecrementUserPostCount:input_type -> posts.v1.DecrementUserPostCountRequest
+	67, // 89: posts.v1.PostService.ListPostsByHashtag:input_type -> posts.v1.ListPostsByHashtagRequest
+	71, // 90: posts.v1.PostService.GetTrending:input_type -> posts.v1.GetTrendingRequest
+	74, // 91: posts.v1.PostService.SearchPosts:input_type -> posts.v1.SearchPostsRequest
+	76, // 92: posts.v1.PostService.CreateUpload:input_type -> posts.v1.CreateUploadRequest
+	12, // 93: posts.v1.PostService.CreateLike:output_type -> posts.v1.CreateLikeResponse
+	16, // 94: posts.v1.PostService.DeleteLike:output_type -> posts.v1.DeleteLikeResponse
+	18, // 95: posts.v1.PostService.DeleteLikeByUser:output_type -> posts.v1.DeleteLikeByUserResponse
+	20, // 96: posts.v1.PostService.ListLikesByPost:output_type -> posts.v1.ListLikesByPostResponse
+	22, // 97: posts.v1.PostService.ListLikedPostsByUser:output_type -> posts.v1.ListLikedPostsByUserResponse
+	57, // 98: posts.v1.PostService.GetHomeTimeline:output_type -> posts.v1.GetHomeTimelineResponse
+	59, // 99: posts.v1.PostService.GetRecommendedFeed:output_type -> posts.v1.GetRecommendedFeedResponse
+	24, // 100: posts.v1.PostService.IncrementPostLikes:output_type -> posts.v1.IncrementPostLikesResponse
+	14, // 101: posts.v1.PostService.CreateLikeByUser:output_type -> posts.v1.CreateLikeByUserResponse
+	48, // 102: posts.v1.PostService.CreatePost:output_type -> posts.v1.CreatePostResponse
+	50, // 103: posts.v1.PostService.GetPost:output_type -> posts.v1.GetPostResponse
+	52, // 104: posts.v1.PostService.ListPostsByUser:output_type -> posts.v1.ListPostsByUserResponse
+	54, // 105: posts.v1.PostService.DeletePost:output_type -> posts.v1.DeletePostResponse
+	7,  // 106: posts.v1.PostService.CreatePostIndexedByUser:output_type -> posts.v1.CreatePostIndexedByUserResponse
+	9,  // 107: posts.v1.PostService.InitializePostEngagements:output_type -> posts.v1.InitializePostEngagementsResponse
+	45, // 108: posts.v1.PostService.UpdatePostEngagements:output_type -> posts.v1.UpdatePostEngagementsResponse
+	42, // 109: posts.v1.PostService.GetPostWithMetadata:output_type -> posts.v1.GetPostWithMetadataResponse
+	26, // 110: posts.v1.PostService.IncrementUserPostCount:output_type -> posts.v1.IncrementUserPostCountResponse
+	28, // 111: posts.v1.PostService.GetThread:output_type -> posts.v1.GetThreadResponse
+	30, // 112: posts.v1.PostService.ListReplies:output_type -> posts.v1.ListRepliesResponse
+	32, // 113: posts.v1.PostService.CreateReplyIndexedByPost:output_type -> posts.v1.CreateReplyIndexedByPostResponse
+	35, // 114: posts.v1.PostService.Repost:output_type -> posts.v1.RepostResponse
+	37, // 115: posts.v1.PostService.UndoRepost:output_type -> posts.v1.UndoRepostResponse
+	39, // 116: posts.v1.PostService.IncrementPostReposts:output_type -> posts.v1.IncrementPostRepostsResponse
+	41, // 117: posts.v1.PostService.DecrementPostReposts:output_type -> posts.v1.DecrementPostRepostsResponse
+	61, // 118: posts.v1.PostService.EditPost:output_type -> posts.v1.EditPostResponse
+	64, // 119: posts.v1.PostService.ListPostRevisions:output_type -> posts.v1.ListPostRevisionsResponse
+	66, // 120: posts.v1.PostService.DecrementUserPostCount:output_type -> posts.v1.DecrementUserPostCountResponse
+	68, // 121: posts.v1.PostService.ListPostsByHashtag:output_type -> posts.v1.ListPostsByHashtagResponse
+	72, // 122: posts.v1.PostService.GetTrending:output_type -> posts.v1.GetTrendingResponse
+	75, // 123: posts.v1.PostService.SearchPosts:output_type -> posts.v1.SearchPostsResponse
+	77, // 124: posts.v1.PostService.CreateUpload:output_type -> posts.v1.CreateUploadResponse
+	93, // [93:125] is the sub-list for method output_type
+	61, // [61:93] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_posts_v1_post_proto_init() }
@@ -1495,13 +4996,14 @@ func file_posts_v1_post_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_v1_post_proto_rawDesc), len(file_posts_v1_post_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   25,
+			NumEnums:      3,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_posts_v1_post_proto_goTypes,
 		DependencyIndexes: file_posts_v1_post_proto_depIdxs,
+		EnumInfos:         file_posts_v1_post_proto_enumTypes,
 		MessageInfos:      file_posts_v1_post_proto_msgTypes,
 	}.Build()
 	File_posts_v1_post_proto = out.File
@@ -35,6 +35,23 @@ const (
 const (
 	// PostServiceCreateLikeProcedure is the fully-qualified name of the PostService's CreateLike RPC.
 	PostServiceCreateLikeProcedure = "/posts.v1.PostService/CreateLike"
+	// PostServiceDeleteLikeProcedure is the fully-qualified name of the PostService's DeleteLike RPC.
+	PostServiceDeleteLikeProcedure = "/posts.v1.PostService/DeleteLike"
+	// PostServiceDeleteLikeByUserProcedure is the fully-qualified name of the PostService's
+	// DeleteLikeByUser RPC.
+	PostServiceDeleteLikeByUserProcedure = "/posts.v1.PostService/DeleteLikeByUser"
+	// PostServiceListLikesByPostProcedure is the fully-qualified name of the PostService's
+	// ListLikesByPost RPC.
+	PostServiceListLikesByPostProcedure = "/posts.v1.PostService/ListLikesByPost"
+	// PostServiceListLikedPostsByUserProcedure is the fully-qualified name of the PostService's
+	// ListLikedPostsByUser RPC.
+	PostServiceListLikedPostsByUserProcedure = "/posts.v1.PostService/ListLikedPostsByUser"
+	// PostServiceGetHomeTimelineProcedure is the fully-qualified name of the PostService's
+	// GetHomeTimeline RPC.
+	PostServiceGetHomeTimelineProcedure = "/posts.v1.PostService/GetHomeTimeline"
+	// PostServiceGetRecommendedFeedProcedure is the fully-qualified name of the PostService's
+	// GetRecommendedFeed RPC.
+	PostServiceGetRecommendedFeedProcedure = "/posts.v1.PostService/GetRecommendedFeed"
 	// PostServiceIncrementPostLikesProcedure is the fully-qualified name of the PostService's
 	// IncrementPostLikes RPC.
 	PostServiceIncrementPostLikesProcedure = "/posts.v1.PostService/IncrementPostLikes"
@@ -62,11 +79,55 @@ const (
 	// PostServiceGetPostWithMetadataProcedure is the fully-qualified name of the PostService's
 	// GetPostWithMetadata RPC.
 	PostServiceGetPostWithMetadataProcedure = "/posts.v1.PostService/GetPostWithMetadata"
+	// PostServiceIncrementUserPostCountProcedure is the fully-qualified name of the PostService's
+	// IncrementUserPostCount RPC.
+	PostServiceIncrementUserPostCountProcedure = "/posts.v1.PostService/IncrementUserPostCount"
+	// PostServiceGetThreadProcedure is the fully-qualified name of the PostService's GetThread RPC.
+	PostServiceGetThreadProcedure = "/posts.v1.PostService/GetThread"
+	// PostServiceListRepliesProcedure is the fully-qualified name of the PostService's ListReplies RPC.
+	PostServiceListRepliesProcedure = "/posts.v1.PostService/ListReplies"
+	// PostServiceCreateReplyIndexedByPostProcedure is the fully-qualified name of the PostService's
+	// CreateReplyIndexedByPost RPC.
+	PostServiceCreateReplyIndexedByPostProcedure = "/posts.v1.PostService/CreateReplyIndexedByPost"
+	// PostServiceRepostProcedure is the fully-qualified name of the PostService's Repost RPC.
+	PostServiceRepostProcedure = "/posts.v1.PostService/Repost"
+	// PostServiceUndoRepostProcedure is the fully-qualified name of the PostService's UndoRepost RPC.
+	PostServiceUndoRepostProcedure = "/posts.v1.PostService/UndoRepost"
+	// PostServiceIncrementPostRepostsProcedure is the fully-qualified name of the PostService's
+	// IncrementPostReposts RPC.
+	PostServiceIncrementPostRepostsProcedure = "/posts.v1.PostService/IncrementPostReposts"
+	// PostServiceDecrementPostRepostsProcedure is the fully-qualified name of the PostService's
+	// DecrementPostReposts RPC.
+	PostServiceDecrementPostRepostsProcedure = "/posts.v1.PostService/DecrementPostReposts"
+	// PostServiceEditPostProcedure is the fully-qualified name of the PostService's EditPost RPC.
+	PostServiceEditPostProcedure = "/posts.v1.PostService/EditPost"
+	// PostServiceListPostRevisionsProcedure is the fully-qualified name of the PostService's
+	// ListPostRevisions RPC.
+	PostServiceListPostRevisionsProcedure = "/posts.v1.PostService/ListPostRevisions"
+	// PostServiceDecrementUserPostCountProcedure is the fully-qualified name of the PostService's
+	// DecrementUserPostCount RPC.
+	PostServiceDecrementUserPostCountProcedure = "/posts.v1.PostService/DecrementUserPostCount"
+	// PostServiceListPostsByHashtagProcedure is the fully-qualified name of the PostService's
+	// ListPostsByHashtag RPC.
+	PostServiceListPostsByHashtagProcedure = "/posts.v1.PostService/ListPostsByHashtag"
+	// PostServiceGetTrendingProcedure is the fully-qualified name of the PostService's GetTrending RPC.
+	PostServiceGetTrendingProcedure = "/posts.v1.PostService/GetTrending"
+	// PostServiceSearchPostsProcedure is the fully-qualified name of the PostService's SearchPosts RPC.
+	PostServiceSearchPostsProcedure = "/posts.v1.PostService/SearchPosts"
+	// PostServiceCreateUploadProcedure is the fully-qualified name of the PostService's CreateUpload
+	// RPC.
+	PostServiceCreateUploadProcedure = "/posts.v1.PostService/CreateUpload"
 )
 
 // PostServiceClient is a client for the posts.v1.PostService service.
 type PostServiceClient interface {
 	CreateLike(context.Context, *connect.Request[v1.CreateLikeRequest]) (*connect.Response[v1.CreateLikeResponse], error)
+	DeleteLike(context.Context, *connect.Request[v1.DeleteLikeRequest]) (*connect.Response[v1.DeleteLikeResponse], error)
+	DeleteLikeByUser(context.Context, *connect.Request[v1.DeleteLikeByUserRequest]) (*connect.Response[v1.DeleteLikeByUserResponse], error)
+	ListLikesByPost(context.Context, *connect.Request[v1.ListLikesByPostRequest]) (*connect.Response[v1.ListLikesByPostResponse], error)
+	ListLikedPostsByUser(context.Context, *connect.Request[v1.ListLikedPostsByUserRequest]) (*connect.Response[v1.ListLikedPostsByUserResponse], error)
+	GetHomeTimeline(context.Context, *connect.Request[v1.GetHomeTimelineRequest]) (*connect.Response[v1.GetHomeTimelineResponse], error)
+	GetRecommendedFeed(context.Context, *connect.Request[v1.GetRecommendedFeedRequest]) (*connect.Response[v1.GetRecommendedFeedResponse], error)
 	IncrementPostLikes(context.Context, *connect.Request[v1.IncrementPostLikesRequest]) (*connect.Response[v1.IncrementPostLikesResponse], error)
 	CreateLikeByUser(context.Context, *connect.Request[v1.CreateLikeByUserRequest]) (*connect.Response[v1.CreateLikeByUserResponse], error)
 	CreatePost(context.Context, *connect.Request[v1.CreatePostRequest]) (*connect.Response[v1.CreatePostResponse], error)
@@ -77,6 +138,21 @@ type PostServiceClient interface {
 	InitializePostEngagements(context.Context, *connect.Request[v1.InitializePostEngagementsRequest]) (*connect.Response[v1.InitializePostEngagementsResponse], error)
 	UpdatePostEngagements(context.Context, *connect.Request[v1.UpdatePostEngagementsRequest]) (*connect.Response[v1.UpdatePostEngagementsResponse], error)
 	GetPostWithMetadata(context.Context, *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostWithMetadataResponse], error)
+	IncrementUserPostCount(context.Context, *connect.Request[v1.IncrementUserPostCountRequest]) (*connect.Response[v1.IncrementUserPostCountResponse], error)
+	GetThread(context.Context, *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error)
+	ListReplies(context.Context, *connect.Request[v1.ListRepliesRequest]) (*connect.Response[v1.ListRepliesResponse], error)
+	CreateReplyIndexedByPost(context.Context, *connect.Request[v1.CreateReplyIndexedByPostRequest]) (*connect.Response[v1.CreateReplyIndexedByPostResponse], error)
+	Repost(context.Context, *connect.Request[v1.RepostRequest]) (*connect.Response[v1.RepostResponse], error)
+	UndoRepost(context.Context, *connect.Request[v1.UndoRepostRequest]) (*connect.Response[v1.UndoRepostResponse], error)
+	IncrementPostReposts(context.Context, *connect.Request[v1.IncrementPostRepostsRequest]) (*connect.Response[v1.IncrementPostRepostsResponse], error)
+	DecrementPostReposts(context.Context, *connect.Request[v1.DecrementPostRepostsRequest]) (*connect.Response[v1.DecrementPostRepostsResponse], error)
+	EditPost(context.Context, *connect.Request[v1.EditPostRequest]) (*connect.Response[v1.EditPostResponse], error)
+	ListPostRevisions(context.Context, *connect.Request[v1.ListPostRevisionsRequest]) (*connect.Response[v1.ListPostRevisionsResponse], error)
+	DecrementUserPostCount(context.Context, *connect.Request[v1.DecrementUserPostCountRequest]) (*connect.Response[v1.DecrementUserPostCountResponse], error)
+	ListPostsByHashtag(context.Context, *connect.Request[v1.ListPostsByHashtagRequest]) (*connect.Response[v1.ListPostsByHashtagResponse], error)
+	GetTrending(context.Context, *connect.Request[v1.GetTrendingRequest]) (*connect.Response[v1.GetTrendingResponse], error)
+	SearchPosts(context.Context, *connect.Request[v1.SearchPostsRequest]) (*connect.Response[v1.SearchPostsResponse], error)
+	CreateUpload(context.Context, *connect.Request[v1.CreateUploadRequest]) (*connect.Response[v1.CreateUploadResponse], error)
 }
 
 // NewPostServiceClient constructs a client for the posts.v1.PostService service. By default, it
@@ -96,6 +172,42 @@ func NewPostServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(postServiceMethods.ByName("CreateLike")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteLike: connect.NewClient[v1.DeleteLikeRequest, v1.DeleteLikeResponse](
+			httpClient,
+			baseURL+PostServiceDeleteLikeProcedure,
+			connect.WithSchema(postServiceMethods.ByName("DeleteLike")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLikeByUser: connect.NewClient[v1.DeleteLikeByUserRequest, v1.DeleteLikeByUserResponse](
+			httpClient,
+			baseURL+PostServiceDeleteLikeByUserProcedure,
+			connect.WithSchema(postServiceMethods.ByName("DeleteLikeByUser")),
+			connect.WithClientOptions(opts...),
+		),
+		listLikesByPost: connect.NewClient[v1.ListLikesByPostRequest, v1.ListLikesByPostResponse](
+			httpClient,
+			baseURL+PostServiceListLikesByPostProcedure,
+			connect.WithSchema(postServiceMethods.ByName("ListLikesByPost")),
+			connect.WithClientOptions(opts...),
+		),
+		listLikedPostsByUser: connect.NewClient[v1.ListLikedPostsByUserRequest, v1.ListLikedPostsByUserResponse](
+			httpClient,
+			baseURL+PostServiceListLikedPostsByUserProcedure,
+			connect.WithSchema(postServiceMethods.ByName("ListLikedPostsByUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getHomeTimeline: connect.NewClient[v1.GetHomeTimelineRequest, v1.GetHomeTimelineResponse](
+			httpClient,
+			baseURL+PostServiceGetHomeTimelineProcedure,
+			connect.WithSchema(postServiceMethods.ByName("GetHomeTimeline")),
+			connect.WithClientOptions(opts...),
+		),
+		getRecommendedFeed: connect.NewClient[v1.GetRecommendedFeedRequest, v1.GetRecommendedFeedResponse](
+			httpClient,
+			baseURL+PostServiceGetRecommendedFeedProcedure,
+			connect.WithSchema(postServiceMethods.ByName("GetRecommendedFeed")),
+			connect.WithClientOptions(opts...),
+		),
 		incrementPostLikes: connect.NewClient[v1.IncrementPostLikesRequest, v1.IncrementPostLikesResponse](
 			httpClient,
 			baseURL+PostServiceIncrementPostLikesProcedure,
@@ -156,12 +268,108 @@ func NewPostServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(postServiceMethods.ByName("GetPostWithMetadata")),
 			connect.WithClientOptions(opts...),
 		),
+		incrementUserPostCount: connect.NewClient[v1.IncrementUserPostCountRequest, v1.IncrementUserPostCountResponse](
+			httpClient,
+			baseURL+PostServiceIncrementUserPostCountProcedure,
+			connect.WithSchema(postServiceMethods.ByName("IncrementUserPostCount")),
+			connect.WithClientOptions(opts...),
+		),
+		getThread: connect.NewClient[v1.GetThreadRequest, v1.GetThreadResponse](
+			httpClient,
+			baseURL+PostServiceGetThreadProcedure,
+			connect.WithSchema(postServiceMethods.ByName("GetThread")),
+			connect.WithClientOptions(opts...),
+		),
+		listReplies: connect.NewClient[v1.ListRepliesRequest, v1.ListRepliesResponse](
+			httpClient,
+			baseURL+PostServiceListRepliesProcedure,
+			connect.WithSchema(postServiceMethods.ByName("ListReplies")),
+			connect.WithClientOptions(opts...),
+		),
+		createReplyIndexedByPost: connect.NewClient[v1.CreateReplyIndexedByPostRequest, v1.CreateReplyIndexedByPostResponse](
+			httpClient,
+			baseURL+PostServiceCreateReplyIndexedByPostProcedure,
+			connect.WithSchema(postServiceMethods.ByName("CreateReplyIndexedByPost")),
+			connect.WithClientOptions(opts...),
+		),
+		repost: connect.NewClient[v1.RepostRequest, v1.RepostResponse](
+			httpClient,
+			baseURL+PostServiceRepostProcedure,
+			connect.WithSchema(postServiceMethods.ByName("Repost")),
+			connect.WithClientOptions(opts...),
+		),
+		undoRepost: connect.NewClient[v1.UndoRepostRequest, v1.UndoRepostResponse](
+			httpClient,
+			baseURL+PostServiceUndoRepostProcedure,
+			connect.WithSchema(postServiceMethods.ByName("UndoRepost")),
+			connect.WithClientOptions(opts...),
+		),
+		incrementPostReposts: connect.NewClient[v1.IncrementPostRepostsRequest, v1.IncrementPostRepostsResponse](
+			httpClient,
+			baseURL+PostServiceIncrementPostRepostsProcedure,
+			connect.WithSchema(postServiceMethods.ByName("IncrementPostReposts")),
+			connect.WithClientOptions(opts...),
+		),
+		decrementPostReposts: connect.NewClient[v1.DecrementPostRepostsRequest, v1.DecrementPostRepostsResponse](
+			httpClient,
+			baseURL+PostServiceDecrementPostRepostsProcedure,
+			connect.WithSchema(postServiceMethods.ByName("DecrementPostReposts")),
+			connect.WithClientOptions(opts...),
+		),
+		editPost: connect.NewClient[v1.EditPostRequest, v1.EditPostResponse](
+			httpClient,
+			baseURL+PostServiceEditPostProcedure,
+			connect.WithSchema(postServiceMethods.ByName("EditPost")),
+			connect.WithClientOptions(opts...),
+		),
+		listPostRevisions: connect.NewClient[v1.ListPostRevisionsRequest, v1.ListPostRevisionsResponse](
+			httpClient,
+			baseURL+PostServiceListPostRevisionsProcedure,
+			connect.WithSchema(postServiceMethods.ByName("ListPostRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		decrementUserPostCount: connect.NewClient[v1.DecrementUserPostCountRequest, v1.DecrementUserPostCountResponse](
+			httpClient,
+			baseURL+PostServiceDecrementUserPostCountProcedure,
+			connect.WithSchema(postServiceMethods.ByName("DecrementUserPostCount")),
+			connect.WithClientOptions(opts...),
+		),
+		listPostsByHashtag: connect.NewClient[v1.ListPostsByHashtagRequest, v1.ListPostsByHashtagResponse](
+			httpClient,
+			baseURL+PostServiceListPostsByHashtagProcedure,
+			connect.WithSchema(postServiceMethods.ByName("ListPostsByHashtag")),
+			connect.WithClientOptions(opts...),
+		),
+		getTrending: connect.NewClient[v1.GetTrendingRequest, v1.GetTrendingResponse](
+			httpClient,
+			baseURL+PostServiceGetTrendingProcedure,
+			connect.WithSchema(postServiceMethods.ByName("GetTrending")),
+			connect.WithClientOptions(opts...),
+		),
+		searchPosts: connect.NewClient[v1.SearchPostsRequest, v1.SearchPostsResponse](
+			httpClient,
+			baseURL+PostServiceSearchPostsProcedure,
+			connect.WithSchema(postServiceMethods.ByName("SearchPosts")),
+			connect.WithClientOptions(opts...),
+		),
+		createUpload: connect.NewClient[v1.CreateUploadRequest, v1.CreateUploadResponse](
+			httpClient,
+			baseURL+PostServiceCreateUploadProcedure,
+			connect.WithSchema(postServiceMethods.ByName("CreateUpload")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // postServiceClient implements PostServiceClient.
 type postServiceClient struct {
 	createLike                *connect.Client[v1.CreateLikeRequest, v1.CreateLikeResponse]
+	deleteLike                *connect.Client[v1.DeleteLikeRequest, v1.DeleteLikeResponse]
+	deleteLikeByUser          *connect.Client[v1.DeleteLikeByUserRequest, v1.DeleteLikeByUserResponse]
+	listLikesByPost           *connect.Client[v1.ListLikesByPostRequest, v1.ListLikesByPostResponse]
+	listLikedPostsByUser      *connect.Client[v1.ListLikedPostsByUserRequest, v1.ListLikedPostsByUserResponse]
+	getHomeTimeline           *connect.Client[v1.GetHomeTimelineRequest, v1.GetHomeTimelineResponse]
+	getRecommendedFeed        *connect.Client[v1.GetRecommendedFeedRequest, v1.GetRecommendedFeedResponse]
 	incrementPostLikes        *connect.Client[v1.IncrementPostLikesRequest, v1.IncrementPostLikesResponse]
 	createLikeByUser          *connect.Client[v1.CreateLikeByUserRequest, v1.CreateLikeByUserResponse]
 	createPost                *connect.Client[v1.CreatePostRequest, v1.CreatePostResponse]
@@ -172,6 +380,21 @@ type postServiceClient struct {
 	initializePostEngagements *connect.Client[v1.InitializePostEngagementsRequest, v1.InitializePostEngagementsResponse]
 	updatePostEngagements     *connect.Client[v1.UpdatePostEngagementsRequest, v1.UpdatePostEngagementsResponse]
 	getPostWithMetadata       *connect.Client[v1.GetPostRequest, v1.GetPostWithMetadataResponse]
+	incrementUserPostCount    *connect.Client[v1.IncrementUserPostCountRequest, v1.IncrementUserPostCountResponse]
+	getThread                 *connect.Client[v1.GetThreadRequest, v1.GetThreadResponse]
+	listReplies               *connect.Client[v1.ListRepliesRequest, v1.ListRepliesResponse]
+	createReplyIndexedByPost  *connect.Client[v1.CreateReplyIndexedByPostRequest, v1.CreateReplyIndexedByPostResponse]
+	repost                    *connect.Client[v1.RepostRequest, v1.RepostResponse]
+	undoRepost                *connect.Client[v1.UndoRepostRequest, v1.UndoRepostResponse]
+	incrementPostReposts      *connect.Client[v1.IncrementPostRepostsRequest, v1.IncrementPostRepostsResponse]
+	decrementPostReposts      *connect.Client[v1.DecrementPostRepostsRequest, v1.DecrementPostRepostsResponse]
+	editPost                  *connect.Client[v1.EditPostRequest, v1.EditPostResponse]
+	listPostRevisions         *connect.Client[v1.ListPostRevisionsRequest, v1.ListPostRevisionsResponse]
+	decrementUserPostCount    *connect.Client[v1.DecrementUserPostCountRequest, v1.DecrementUserPostCountResponse]
+	listPostsByHashtag        *connect.Client[v1.ListPostsByHashtagRequest, v1.ListPostsByHashtagResponse]
+	getTrending               *connect.Client[v1.GetTrendingRequest, v1.GetTrendingResponse]
+	searchPosts               *connect.Client[v1.SearchPostsRequest, v1.SearchPostsResponse]
+	createUpload              *connect.Client[v1.CreateUploadRequest, v1.CreateUploadResponse]
 }
 
 // CreateLike calls posts.v1.PostService.CreateLike.
@@ -179,6 +402,36 @@ func (c *postServiceClient) CreateLike(ctx context.Context, req *connect.Request
 	return c.createLike.CallUnary(ctx, req)
 }
 
+// DeleteLike calls posts.v1.PostService.DeleteLike.
+func (c *postServiceClient) DeleteLike(ctx context.Context, req *connect.Request[v1.DeleteLikeRequest]) (*connect.Response[v1.DeleteLikeResponse], error) {
+	return c.deleteLike.CallUnary(ctx, req)
+}
+
+// DeleteLikeByUser calls posts.v1.PostService.DeleteLikeByUser.
+func (c *postServiceClient) DeleteLikeByUser(ctx context.Context, req *connect.Request[v1.DeleteLikeByUserRequest]) (*connect.Response[v1.DeleteLikeByUserResponse], error) {
+	return c.deleteLikeByUser.CallUnary(ctx, req)
+}
+
+// ListLikesByPost calls posts.v1.PostService.ListLikesByPost.
+func (c *postServiceClient) ListLikesByPost(ctx context.Context, req *connect.Request[v1.ListLikesByPostRequest]) (*connect.Response[v1.ListLikesByPostResponse], error) {
+	return c.listLikesByPost.CallUnary(ctx, req)
+}
+
+// ListLikedPostsByUser calls posts.v1.PostService.ListLikedPostsByUser.
+func (c *postServiceClient) ListLikedPostsByUser(ctx context.Context, req *connect.Request[v1.ListLikedPostsByUserRequest]) (*connect.Response[v1.ListLikedPostsByUserResponse], error) {
+	return c.listLikedPostsByUser.CallUnary(ctx, req)
+}
+
+// GetHomeTimeline calls posts.v1.PostService.GetHomeTimeline.
+func (c *postServiceClient) GetHomeTimeline(ctx context.Context, req *connect.Request[v1.GetHomeTimelineRequest]) (*connect.Response[v1.GetHomeTimelineResponse], error) {
+	return c.getHomeTimeline.CallUnary(ctx, req)
+}
+
+// GetRecommendedFeed calls posts.v1.PostService.GetRecommendedFeed.
+func (c *postServiceClient) GetRecommendedFeed(ctx context.Context, req *connect.Request[v1.GetRecommendedFeedRequest]) (*connect.Response[v1.GetRecommendedFeedResponse], error) {
+	return c.getRecommendedFeed.CallUnary(ctx, req)
+}
+
 // IncrementPostLikes calls posts.v1.PostService.IncrementPostLikes.
 func (c *postServiceClient) IncrementPostLikes(ctx context.Context, req *connect.Request[v1.IncrementPostLikesRequest]) (*connect.Response[v1.IncrementPostLikesResponse], error) {
 	return c.incrementPostLikes.CallUnary(ctx, req)
@@ -229,9 +482,90 @@ func (c *postServiceClient) GetPostWithMetadata(ctx context.Context, req *connec
 	return c.getPostWithMetadata.CallUnary(ctx, req)
 }
 
+// IncrementUserPostCount calls posts.v1.PostService.IncrementUserPostCount.
+func (c *postServiceClient) IncrementUserPostCount(ctx context.Context, req *connect.Request[v1.IncrementUserPostCountRequest]) (*connect.Response[v1.IncrementUserPostCountResponse], error) {
+	return c.incrementUserPostCount.CallUnary(ctx, req)
+}
+
+// GetThread calls posts.v1.PostService.GetThread.
+func (c *postServiceClient) GetThread(ctx context.Context, req *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error) {
+	return c.getThread.CallUnary(ctx, req)
+}
+
+// ListReplies calls posts.v1.PostService.ListReplies.
+func (c *postServiceClient) ListReplies(ctx context.Context, req *connect.Request[v1.ListRepliesRequest]) (*connect.Response[v1.ListRepliesResponse], error) {
+	return c.listReplies.CallUnary(ctx, req)
+}
+
+// CreateReplyIndexedByPost calls posts.v1.PostService.CreateReplyIndexedByPost.
+func (c *postServiceClient) CreateReplyIndexedByPost(ctx context.Context, req *connect.Request[v1.CreateReplyIndexedByPostRequest]) (*connect.Response[v1.CreateReplyIndexedByPostResponse], error) {
+	return c.createReplyIndexedByPost.CallUnary(ctx, req)
+}
+
+// Repost calls posts.v1.PostService.Repost.
+func (c *postServiceClient) Repost(ctx context.Context, req *connect.Request[v1.RepostRequest]) (*connect.Response[v1.RepostResponse], error) {
+	return c.repost.CallUnary(ctx, req)
+}
+
+// UndoRepost calls posts.v1.PostService.UndoRepost.
+func (c *postServiceClient) UndoRepost(ctx context.Context, req *connect.Request[v1.UndoRepostRequest]) (*connect.Response[v1.UndoRepostResponse], error) {
+	return c.undoRepost.CallUnary(ctx, req)
+}
+
+// IncrementPostReposts calls posts.v1.PostService.IncrementPostReposts.
+func (c *postServiceClient) IncrementPostReposts(ctx context.Context, req *connect.Request[v1.IncrementPostRepostsRequest]) (*connect.Response[v1.IncrementPostRepostsResponse], error) {
+	return c.incrementPostReposts.CallUnary(ctx, req)
+}
+
+// DecrementPostReposts calls posts.v1.PostService.DecrementPostReposts.
+func (c *postServiceClient) DecrementPostReposts(ctx context.Context, req *connect.Request[v1.DecrementPostRepostsRequest]) (*connect.Response[v1.DecrementPostRepostsResponse], error) {
+	return c.decrementPostReposts.CallUnary(ctx, req)
+}
+
+// EditPost calls posts.v1.PostService.EditPost.
+func (c *postServiceClient) EditPost(ctx context.Context, req *connect.Request[v1.EditPostRequest]) (*connect.Response[v1.EditPostResponse], error) {
+	return c.editPost.CallUnary(ctx, req)
+}
+
+// ListPostRevisions calls posts.v1.PostService.ListPostRevisions.
+func (c *postServiceClient) ListPostRevisions(ctx context.Context, req *connect.Request[v1.ListPostRevisionsRequest]) (*connect.Response[v1.ListPostRevisionsResponse], error) {
+	return c.listPostRevisions.CallUnary(ctx, req)
+}
+
+// DecrementUserPostCount calls posts.v1.PostService.DecrementUserPostCount.
+func (c *postServiceClient) DecrementUserPostCount(ctx context.Context, req *connect.Request[v1.DecrementUserPostCountRequest]) (*connect.Response[v1.DecrementUserPostCountResponse], error) {
+	return c.decrementUserPostCount.CallUnary(ctx, req)
+}
+
+// ListPostsByHashtag calls posts.v1.PostService.ListPostsByHashtag.
+func (c *postServiceClient) ListPostsByHashtag(ctx context.Context, req *connect.Request[v1.ListPostsByHashtagRequest]) (*connect.Response[v1.ListPostsByHashtagResponse], error) {
+	return c.listPostsByHashtag.CallUnary(ctx, req)
+}
+
+// GetTrending calls posts.v1.PostService.GetTrending.
+func (c *postServiceClient) GetTrending(ctx context.Context, req *connect.Request[v1.GetTrendingRequest]) (*connect.Response[v1.GetTrendingResponse], error) {
+	return c.getTrending.CallUnary(ctx, req)
+}
+
+// SearchPosts calls posts.v1.PostService.SearchPosts.
+func (c *postServiceClient) SearchPosts(ctx context.Context, req *connect.Request[v1.SearchPostsRequest]) (*connect.Response[v1.SearchPostsResponse], error) {
+	return c.searchPosts.CallUnary(ctx, req)
+}
+
+// CreateUpload calls posts.v1.PostService.CreateUpload.
+func (c *postServiceClient) CreateUpload(ctx context.Context, req *connect.Request[v1.CreateUploadRequest]) (*connect.Response[v1.CreateUploadResponse], error) {
+	return c.createUpload.CallUnary(ctx, req)
+}
+
 // PostServiceHandler is an implementation of the posts.v1.PostService service.
 type PostServiceHandler interface {
 	CreateLike(context.Context, *connect.Request[v1.CreateLikeRequest]) (*connect.Response[v1.CreateLikeResponse], error)
+	DeleteLike(context.Context, *connect.Request[v1.DeleteLikeRequest]) (*connect.Response[v1.DeleteLikeResponse], error)
+	DeleteLikeByUser(context.Context, *connect.Request[v1.DeleteLikeByUserRequest]) (*connect.Response[v1.DeleteLikeByUserResponse], error)
+	ListLikesByPost(context.Context, *connect.Request[v1.ListLikesByPostRequest]) (*connect.Response[v1.ListLikesByPostResponse], error)
+	ListLikedPostsByUser(context.Context, *connect.Request[v1.ListLikedPostsByUserRequest]) (*connect.Response[v1.ListLikedPostsByUserResponse], error)
+	GetHomeTimeline(context.Context, *connect.Request[v1.GetHomeTimelineRequest]) (*connect.Response[v1.GetHomeTimelineResponse], error)
+	GetRecommendedFeed(context.Context, *connect.Request[v1.GetRecommendedFeedRequest]) (*connect.Response[v1.GetRecommendedFeedResponse], error)
 	IncrementPostLikes(context.Context, *connect.Request[v1.IncrementPostLikesRequest]) (*connect.Response[v1.IncrementPostLikesResponse], error)
 	CreateLikeByUser(context.Context, *connect.Request[v1.CreateLikeByUserRequest]) (*connect.Response[v1.CreateLikeByUserResponse], error)
 	CreatePost(context.Context, *connect.Request[v1.CreatePostRequest]) (*connect.Response[v1.CreatePostResponse], error)
@@ -242,6 +576,21 @@ type PostServiceHandler interface {
 	InitializePostEngagements(context.Context, *connect.Request[v1.InitializePostEngagementsRequest]) (*connect.Response[v1.InitializePostEngagementsResponse], error)
 	UpdatePostEngagements(context.Context, *connect.Request[v1.UpdatePostEngagementsRequest]) (*connect.Response[v1.UpdatePostEngagementsResponse], error)
 	GetPostWithMetadata(context.Context, *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostWithMetadataResponse], error)
+	IncrementUserPostCount(context.Context, *connect.Request[v1.IncrementUserPostCountRequest]) (*connect.Response[v1.IncrementUserPostCountResponse], error)
+	GetThread(context.Context, *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error)
+	ListReplies(context.Context, *connect.Request[v1.ListRepliesRequest]) (*connect.Response[v1.ListRepliesResponse], error)
+	CreateReplyIndexedByPost(context.Context, *connect.Request[v1.CreateReplyIndexedByPostRequest]) (*connect.Response[v1.CreateReplyIndexedByPostResponse], error)
+	Repost(context.Context, *connect.Request[v1.RepostRequest]) (*connect.Response[v1.RepostResponse], error)
+	UndoRepost(context.Context, *connect.Request[v1.UndoRepostRequest]) (*connect.Response[v1.UndoRepostResponse], error)
+	IncrementPostReposts(context.Context, *connect.Request[v1.IncrementPostRepostsRequest]) (*connect.Response[v1.IncrementPostRepostsResponse], error)
+	DecrementPostReposts(context.Context, *connect.Request[v1.DecrementPostRepostsRequest]) (*connect.Response[v1.DecrementPostRepostsResponse], error)
+	EditPost(context.Context, *connect.Request[v1.EditPostRequest]) (*connect.Response[v1.EditPostResponse], error)
+	ListPostRevisions(context.Context, *connect.Request[v1.ListPostRevisionsRequest]) (*connect.Response[v1.ListPostRevisionsResponse], error)
+	DecrementUserPostCount(context.Context, *connect.Request[v1.DecrementUserPostCountRequest]) (*connect.Response[v1.DecrementUserPostCountResponse], error)
+	ListPostsByHashtag(context.Context, *connect.Request[v1.ListPostsByHashtagRequest]) (*connect.Response[v1.ListPostsByHashtagResponse], error)
+	GetTrending(context.Context, *connect.Request[v1.GetTrendingRequest]) (*connect.Response[v1.GetTrendingResponse], error)
+	SearchPosts(context.Context, *connect.Request[v1.SearchPostsRequest]) (*connect.Response[v1.SearchPostsResponse], error)
+	CreateUpload(context.Context, *connect.Request[v1.CreateUploadRequest]) (*connect.Response[v1.CreateUploadResponse], error)
 }
 
 // NewPostServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -257,6 +606,42 @@ func NewPostServiceHandler(svc PostServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(postServiceMethods.ByName("CreateLike")),
 		connect.WithHandlerOptions(opts...),
 	)
+	postServiceDeleteLikeHandler := connect.NewUnaryHandler(
+		PostServiceDeleteLikeProcedure,
+		svc.DeleteLike,
+		connect.WithSchema(postServiceMethods.ByName("DeleteLike")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceDeleteLikeByUserHandler := connect.NewUnaryHandler(
+		PostServiceDeleteLikeByUserProcedure,
+		svc.DeleteLikeByUser,
+		connect.WithSchema(postServiceMethods.ByName("DeleteLikeByUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceListLikesByPostHandler := connect.NewUnaryHandler(
+		PostServiceListLikesByPostProcedure,
+		svc.ListLikesByPost,
+		connect.WithSchema(postServiceMethods.ByName("ListLikesByPost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceListLikedPostsByUserHandler := connect.NewUnaryHandler(
+		PostServiceListLikedPostsByUserProcedure,
+		svc.ListLikedPostsByUser,
+		connect.WithSchema(postServiceMethods.ByName("ListLikedPostsByUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceGetHomeTimelineHandler := connect.NewUnaryHandler(
+		PostServiceGetHomeTimelineProcedure,
+		svc.GetHomeTimeline,
+		connect.WithSchema(postServiceMethods.ByName("GetHomeTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceGetRecommendedFeedHandler := connect.NewUnaryHandler(
+		PostServiceGetRecommendedFeedProcedure,
+		svc.GetRecommendedFeed,
+		connect.WithSchema(postServiceMethods.ByName("GetRecommendedFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
 	postServiceIncrementPostLikesHandler := connect.NewUnaryHandler(
 		PostServiceIncrementPostLikesProcedure,
 		svc.IncrementPostLikes,
@@ -317,10 +702,112 @@ func NewPostServiceHandler(svc PostServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(postServiceMethods.ByName("GetPostWithMetadata")),
 		connect.WithHandlerOptions(opts...),
 	)
+	postServiceIncrementUserPostCountHandler := connect.NewUnaryHandler(
+		PostServiceIncrementUserPostCountProcedure,
+		svc.IncrementUserPostCount,
+		connect.WithSchema(postServiceMethods.ByName("IncrementUserPostCount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceGetThreadHandler := connect.NewUnaryHandler(
+		PostServiceGetThreadProcedure,
+		svc.GetThread,
+		connect.WithSchema(postServiceMethods.ByName("GetThread")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceListRepliesHandler := connect.NewUnaryHandler(
+		PostServiceListRepliesProcedure,
+		svc.ListReplies,
+		connect.WithSchema(postServiceMethods.ByName("ListReplies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceCreateReplyIndexedByPostHandler := connect.NewUnaryHandler(
+		PostServiceCreateReplyIndexedByPostProcedure,
+		svc.CreateReplyIndexedByPost,
+		connect.WithSchema(postServiceMethods.ByName("CreateReplyIndexedByPost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceRepostHandler := connect.NewUnaryHandler(
+		PostServiceRepostProcedure,
+		svc.Repost,
+		connect.WithSchema(postServiceMethods.ByName("Repost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceUndoRepostHandler := connect.NewUnaryHandler(
+		PostServiceUndoRepostProcedure,
+		svc.UndoRepost,
+		connect.WithSchema(postServiceMethods.ByName("UndoRepost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceIncrementPostRepostsHandler := connect.NewUnaryHandler(
+		PostServiceIncrementPostRepostsProcedure,
+		svc.IncrementPostReposts,
+		connect.WithSchema(postServiceMethods.ByName("IncrementPostReposts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceDecrementPostRepostsHandler := connect.NewUnaryHandler(
+		PostServiceDecrementPostRepostsProcedure,
+		svc.DecrementPostReposts,
+		connect.WithSchema(postServiceMethods.ByName("DecrementPostReposts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceEditPostHandler := connect.NewUnaryHandler(
+		PostServiceEditPostProcedure,
+		svc.EditPost,
+		connect.WithSchema(postServiceMethods.ByName("EditPost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceListPostRevisionsHandler := connect.NewUnaryHandler(
+		PostServiceListPostRevisionsProcedure,
+		svc.ListPostRevisions,
+		connect.WithSchema(postServiceMethods.ByName("ListPostRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceDecrementUserPostCountHandler := connect.NewUnaryHandler(
+		PostServiceDecrementUserPostCountProcedure,
+		svc.DecrementUserPostCount,
+		connect.WithSchema(postServiceMethods.ByName("DecrementUserPostCount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceListPostsByHashtagHandler := connect.NewUnaryHandler(
+		PostServiceListPostsByHashtagProcedure,
+		svc.ListPostsByHashtag,
+		connect.WithSchema(postServiceMethods.ByName("ListPostsByHashtag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceGetTrendingHandler := connect.NewUnaryHandler(
+		PostServiceGetTrendingProcedure,
+		svc.GetTrending,
+		connect.WithSchema(postServiceMethods.ByName("GetTrending")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceSearchPostsHandler := connect.NewUnaryHandler(
+		PostServiceSearchPostsProcedure,
+		svc.SearchPosts,
+		connect.WithSchema(postServiceMethods.ByName("SearchPosts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	postServiceCreateUploadHandler := connect.NewUnaryHandler(
+		PostServiceCreateUploadProcedure,
+		svc.CreateUpload,
+		connect.WithSchema(postServiceMethods.ByName("CreateUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/posts.v1.PostService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PostServiceCreateLikeProcedure:
 			postServiceCreateLikeHandler.ServeHTTP(w, r)
+		case PostServiceDeleteLikeProcedure:
+			postServiceDeleteLikeHandler.ServeHTTP(w, r)
+		case PostServiceDeleteLikeByUserProcedure:
+			postServiceDeleteLikeByUserHandler.ServeHTTP(w, r)
+		case PostServiceListLikesByPostProcedure:
+			postServiceListLikesByPostHandler.ServeHTTP(w, r)
+		case PostServiceListLikedPostsByUserProcedure:
+			postServiceListLikedPostsByUserHandler.ServeHTTP(w, r)
+		case PostServiceGetHomeTimelineProcedure:
+			postServiceGetHomeTimelineHandler.ServeHTTP(w, r)
+		case PostServiceGetRecommendedFeedProcedure:
+			postServiceGetRecommendedFeedHandler.ServeHTTP(w, r)
 		case PostServiceIncrementPostLikesProcedure:
 			postServiceIncrementPostLikesHandler.ServeHTTP(w, r)
 		case PostServiceCreateLikeByUserProcedure:
@@ -341,6 +828,36 @@ func NewPostServiceHandler(svc PostServiceHandler, opts ...connect.HandlerOption
 			postServiceUpdatePostEngagementsHandler.ServeHTTP(w, r)
 		case PostServiceGetPostWithMetadataProcedure:
 			postServiceGetPostWithMetadataHandler.ServeHTTP(w, r)
+		case PostServiceIncrementUserPostCountProcedure:
+			postServiceIncrementUserPostCountHandler.ServeHTTP(w, r)
+		case PostServiceGetThreadProcedure:
+			postServiceGetThreadHandler.ServeHTTP(w, r)
+		case PostServiceListRepliesProcedure:
+			postServiceListRepliesHandler.ServeHTTP(w, r)
+		case PostServiceCreateReplyIndexedByPostProcedure:
+			postServiceCreateReplyIndexedByPostHandler.ServeHTTP(w, r)
+		case PostServiceRepostProcedure:
+			postServiceRepostHandler.ServeHTTP(w, r)
+		case PostServiceUndoRepostProcedure:
+			postServiceUndoRepostHandler.ServeHTTP(w, r)
+		case PostServiceIncrementPostRepostsProcedure:
+			postServiceIncrementPostRepostsHandler.ServeHTTP(w, r)
+		case PostServiceDecrementPostRepostsProcedure:
+			postServiceDecrementPostRepostsHandler.ServeHTTP(w, r)
+		case PostServiceEditPostProcedure:
+			postServiceEditPostHandler.ServeHTTP(w, r)
+		case PostServiceListPostRevisionsProcedure:
+			postServiceListPostRevisionsHandler.ServeHTTP(w, r)
+		case PostServiceDecrementUserPostCountProcedure:
+			postServiceDecrementUserPostCountHandler.ServeHTTP(w, r)
+		case PostServiceListPostsByHashtagProcedure:
+			postServiceListPostsByHashtagHandler.ServeHTTP(w, r)
+		case PostServiceGetTrendingProcedure:
+			postServiceGetTrendingHandler.ServeHTTP(w, r)
+		case PostServiceSearchPostsProcedure:
+			postServiceSearchPostsHandler.ServeHTTP(w, r)
+		case PostServiceCreateUploadProcedure:
+			postServiceCreateUploadHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -354,6 +871,30 @@ func (UnimplementedPostServiceHandler) CreateLike(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.CreateLike is not implemented"))
 }
 
+func (UnimplementedPostServiceHandler) DeleteLike(context.Context, *connect.Request[v1.DeleteLikeRequest]) (*connect.Response[v1.DeleteLikeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.DeleteLike is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) DeleteLikeByUser(context.Context, *connect.Request[v1.DeleteLikeByUserRequest]) (*connect.Response[v1.DeleteLikeByUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.DeleteLikeByUser is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) ListLikesByPost(context.Context, *connect.Request[v1.ListLikesByPostRequest]) (*connect.Response[v1.ListLikesByPostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.ListLikesByPost is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) ListLikedPostsByUser(context.Context, *connect.Request[v1.ListLikedPostsByUserRequest]) (*connect.Response[v1.ListLikedPostsByUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.ListLikedPostsByUser is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) GetHomeTimeline(context.Context, *connect.Request[v1.GetHomeTimelineRequest]) (*connect.Response[v1.GetHomeTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.GetHomeTimeline is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) GetRecommendedFeed(context.Context, *connect.Request[v1.GetRecommendedFeedRequest]) (*connect.Response[v1.GetRecommendedFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.GetRecommendedFeed is not implemented"))
+}
+
 func (UnimplementedPostServiceHandler) IncrementPostLikes(context.Context, *connect.Request[v1.IncrementPostLikesRequest]) (*connect.Response[v1.IncrementPostLikesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.IncrementPostLikes is not implemented"))
 }
@@ -393,3 +934,63 @@ func (UnimplementedPostServiceHandler) UpdatePostEngagements(context.Context, *c
 func (UnimplementedPostServiceHandler) GetPostWithMetadata(context.Context, *connect.Request[v1.GetPostRequest]) (*connect.Response[v1.GetPostWithMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.GetPostWithMetadata is not implemented"))
 }
+
+func (UnimplementedPostServiceHandler) IncrementUserPostCount(context.Context, *connect.Request[v1.IncrementUserPostCountRequest]) (*connect.Response[v1.IncrementUserPostCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.IncrementUserPostCount is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) GetThread(context.Context, *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.GetThread is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) ListReplies(context.Context, *connect.Request[v1.ListRepliesRequest]) (*connect.Response[v1.ListRepliesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.ListReplies is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) CreateReplyIndexedByPost(context.Context, *connect.Request[v1.CreateReplyIndexedByPostRequest]) (*connect.Response[v1.CreateReplyIndexedByPostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.CreateReplyIndexedByPost is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) Repost(context.Context, *connect.Request[v1.RepostRequest]) (*connect.Response[v1.RepostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.Repost is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) UndoRepost(context.Context, *connect.Request[v1.UndoRepostRequest]) (*connect.Response[v1.UndoRepostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.UndoRepost is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) IncrementPostReposts(context.Context, *connect.Request[v1.IncrementPostRepostsRequest]) (*connect.Response[v1.IncrementPostRepostsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.IncrementPostReposts is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) DecrementPostReposts(context.Context, *connect.Request[v1.DecrementPostRepostsRequest]) (*connect.Response[v1.DecrementPostRepostsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.DecrementPostReposts is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) EditPost(context.Context, *connect.Request[v1.EditPostRequest]) (*connect.Response[v1.EditPostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.EditPost is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) ListPostRevisions(context.Context, *connect.Request[v1.ListPostRevisionsRequest]) (*connect.Response[v1.ListPostRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.ListPostRevisions is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) DecrementUserPostCount(context.Context, *connect.Request[v1.DecrementUserPostCountRequest]) (*connect.Response[v1.DecrementUserPostCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.DecrementUserPostCount is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) ListPostsByHashtag(context.Context, *connect.Request[v1.ListPostsByHashtagRequest]) (*connect.Response[v1.ListPostsByHashtagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.ListPostsByHashtag is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) GetTrending(context.Context, *connect.Request[v1.GetTrendingRequest]) (*connect.Response[v1.GetTrendingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.GetTrending is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) SearchPosts(context.Context, *connect.Request[v1.SearchPostsRequest]) (*connect.Response[v1.SearchPostsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.SearchPosts is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) CreateUpload(context.Context, *connect.Request[v1.CreateUploadRequest]) (*connect.Response[v1.CreateUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("posts.v1.PostService.CreateUpload is not implemented"))
+}
@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TrendingActivity int32
+
+const (
+	TrendingActivity_TRENDING_ACTIVITY_UNSPECIFIED TrendingActivity = 0
+	TrendingActivity_TRENDING_ACTIVITY_POST        TrendingActivity = 1 // the actor wrote the post
+	TrendingActivity_TRENDING_ACTIVITY_LIKE        TrendingActivity = 2 // the actor liked the post
+)
+
+// Enum value maps for TrendingActivity.
+var (
+	TrendingActivity_name = map[int32]string{
+		0: "TRENDING_ACTIVITY_UNSPECIFIED",
+		1: "TRENDING_ACTIVITY_POST",
+		2: "TRENDING_ACTIVITY_LIKE",
+	}
+	TrendingActivity_value = map[string]int32{
+		"TRENDING_ACTIVITY_UNSPECIFIED": 0,
+		"TRENDING_ACTIVITY_POST":        1,
+		"TRENDING_ACTIVITY_LIKE":        2,
+	}
+)
+
+func (x TrendingActivity) Enum() *TrendingActivity {
+	p := new(TrendingActivity)
+	*p = x
+	return p
+}
+
+func (x TrendingActivity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendingActivity) Descriptor() protoreflect.EnumDescriptor {
+	return file_processor_v1_processor_proto_enumTypes[0].Descriptor()
+}
+
+func (TrendingActivity) Type() protoreflect.EnumType {
+	return &file_processor_v1_processor_proto_enumTypes[0]
+}
+
+func (x TrendingActivity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendingActivity.Descriptor instead.
+func (TrendingActivity) EnumDescriptor() ([]byte, []int) {
+	return file_processor_v1_processor_proto_rawDescGZIP(), []int{0}
+}
+
 type OutboxMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -191,7 +240,11 @@ const file_processor_v1_processor_proto_rawDesc = "" +
 	"\x1bProcessOutboxMessageRequest\x12\x1c\n" +
 	"\tpublished\x18\x01 \x01(\bR\tpublished\"G\n" +
 	"\x1cProcessOutboxMessageResponse\x12'\n" +
-	"\x0fprocessed_count\x18\x01 \x01(\x05R\x0eprocessedCount2\x81\x01\n" +
+	"\x0fprocessed_count\x18\x01 \x01(\x05R\x0eprocessedCount*m\n" +
+	"\x10TrendingActivity\x12!\n" +
+	"\x1dTRENDING_ACTIVITY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRENDING_ACTIVITY_POST\x10\x01\x12\x1a\n" +
+	"\x16TRENDING_ACTIVITY_LIKE\x10\x022\x81\x01\n" +
 	"\x10ProcessorService\x12m\n" +
 	"\x14ProcessOutboxMessage\x12).processor.v1.ProcessOutboxMessageRequest\x1a*.processor.v1.ProcessOutboxMessageResponseB\xbc\x01\n" +
 	"\x10com.processor.v1B\x0eProcessorProtoP\x01ZGgithub.com/yaninyzwitty/threads-go-backend/gen/processor/v1;processorv1\xa2\x02\x03PXX\xaa\x02\fProcessor.V1\xca\x02\fProcessor\\V1\xe2\x02\x18Processor\\V1\\GPBMetadata\xea\x02\rProcessor::V1b\x06proto3"
@@ -208,15 +261,17 @@ func file_processor_v1_processor_proto_rawDescGZIP() []byte {
 	return file_processor_v1_processor_proto_rawDescData
 }
 
+var file_processor_v1_processor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_processor_v1_processor_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_processor_v1_processor_proto_goTypes = []any{
-	(*OutboxMessage)(nil),                // 0: processor.v1.OutboxMessage
-	(*ProcessOutboxMessageRequest)(nil),  // 1: processor.v1.ProcessOutboxMessageRequest
-	(*ProcessOutboxMessageResponse)(nil), // 2: processor.v1.ProcessOutboxMessageResponse
+	(TrendingActivity)(0),                // 0: processor.v1.TrendingActivity
+	(*OutboxMessage)(nil),                // 1: processor.v1.OutboxMessage
+	(*ProcessOutboxMessageRequest)(nil),  // 2: processor.v1.ProcessOutboxMessageRequest
+	(*ProcessOutboxMessageResponse)(nil), // 3: processor.v1.ProcessOutboxMessageResponse
 }
 var file_processor_v1_processor_proto_depIdxs = []int32{
-	1, // 0: processor.v1.ProcessorService.ProcessOutboxMessage:input_type -> processor.v1.ProcessOutboxMessageRequest
-	2, // 1: processor.v1.ProcessorService.ProcessOutboxMessage:output_type -> processor.v1.ProcessOutboxMessageResponse
+	2, // 0: processor.v1.ProcessorService.ProcessOutboxMessage:input_type -> processor.v1.ProcessOutboxMessageRequest
+	3, // 1: processor.v1.ProcessorService.ProcessOutboxMessage:output_type -> processor.v1.ProcessOutboxMessageResponse
 	1, // [1:2] is the sub-list for method output_type
 	0, // [0:1] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
@@ -234,13 +289,14 @@ func file_processor_v1_processor_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_processor_v1_processor_proto_rawDesc), len(file_processor_v1_processor_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_processor_v1_processor_proto_goTypes,
 		DependencyIndexes: file_processor_v1_processor_proto_depIdxs,
+		EnumInfos:         file_processor_v1_processor_proto_enumTypes,
 		MessageInfos:      file_processor_v1_processor_proto_msgTypes,
 	}.Build()
 	File_processor_v1_processor_proto = out.File
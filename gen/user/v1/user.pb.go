@@ -22,6 +22,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TextEntity_Type int32
+
+const (
+	TextEntity_TYPE_UNSPECIFIED TextEntity_Type = 0
+	TextEntity_TYPE_HASHTAG     TextEntity_Type = 1
+	TextEntity_TYPE_MENTION     TextEntity_Type = 2
+	TextEntity_TYPE_URL         TextEntity_Type = 3
+)
+
+// Enum value maps for TextEntity_Type.
+var (
+	TextEntity_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_HASHTAG",
+		2: "TYPE_MENTION",
+		3: "TYPE_URL",
+	}
+	TextEntity_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_HASHTAG":     1,
+		"TYPE_MENTION":     2,
+		"TYPE_URL":         3,
+	}
+)
+
+func (x TextEntity_Type) Enum() *TextEntity_Type {
+	p := new(TextEntity_Type)
+	*p = x
+	return p
+}
+
+func (x TextEntity_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextEntity_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (TextEntity_Type) Type() protoreflect.EnumType {
+	return &file_user_v1_user_proto_enumTypes[0]
+}
+
+func (x TextEntity_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextEntity_Type.Descriptor instead.
+func (TextEntity_Type) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,7 +84,11 @@ type User struct {
 	IsVerified    bool                   `protobuf:"varint,6,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Password      string                 `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`
+	Bio           string                 `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
+	Links         []string               `protobuf:"bytes,11,rep,name=links,proto3" json:"links,omitempty"`
+	Pronouns      string                 `protobuf:"bytes,12,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	Location      string                 `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	BannerUrl     string                 `protobuf:"bytes,14,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,37 +179,68 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *User) GetPassword() string {
+func (x *User) GetBio() string {
 	if x != nil {
-		return x.Password
+		return x.Bio
 	}
 	return ""
 }
 
-type OutboxEvent struct {
+func (x *User) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *User) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *User) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *User) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+// A parsed span of user text. Offsets are Unicode code points, end exclusive.
+type TextEntity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	Published     bool                   `protobuf:"varint,4,opt,name=published,proto3" json:"published,omitempty"`
+	Type          TextEntity_Type        `protobuf:"varint,1,opt,name=type,proto3,enum=user.v1.TextEntity_Type" json:"type,omitempty"`
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                    // as written, including '#' or '@'
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`                  // normalized tag, bare username or URL
+	UserId        int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // mentions only: the user the handle resolved to
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OutboxEvent) Reset() {
-	*x = OutboxEvent{}
+func (x *TextEntity) Reset() {
+	*x = TextEntity{}
 	mi := &file_user_v1_user_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OutboxEvent) String() string {
+func (x *TextEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutboxEvent) ProtoMessage() {}
+func (*TextEntity) ProtoMessage() {}
 
-func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
+func (x *TextEntity) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -165,63 +252,90 @@ func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutboxEvent.ProtoReflect.Descriptor instead.
-func (*OutboxEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextEntity.ProtoReflect.Descriptor instead.
+func (*TextEntity) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *OutboxEvent) GetEventId() string {
+func (x *TextEntity) GetType() TextEntity_Type {
 	if x != nil {
-		return x.EventId
+		return x.Type
 	}
-	return ""
+	return TextEntity_TYPE_UNSPECIFIED
 }
 
-func (x *OutboxEvent) GetEventType() string {
+func (x *TextEntity) GetStart() int32 {
 	if x != nil {
-		return x.EventType
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *OutboxEvent) GetPayload() string {
+func (x *TextEntity) GetEnd() int32 {
 	if x != nil {
-		return x.Payload
+		return x.End
+	}
+	return 0
+}
+
+func (x *TextEntity) GetText() string {
+	if x != nil {
+		return x.Text
 	}
 	return ""
 }
 
-func (x *OutboxEvent) GetPublished() bool {
+func (x *TextEntity) GetValue() string {
 	if x != nil {
-		return x.Published
+		return x.Value
 	}
-	return false
+	return ""
 }
 
-type FollowedEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId   int64                  `protobuf:"varint,3,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
-	FollowedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TextEntity) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *FollowedEvent) Reset() {
-	*x = FollowedEvent{}
+// Public view of a user: no email or credentials.
+type UserProfile struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FullName           string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	ProfilePicUrl      string                 `protobuf:"bytes,4,opt,name=profile_pic_url,json=profilePicUrl,proto3" json:"profile_pic_url,omitempty"`
+	IsVerified         bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FollowerCount      int64                  `protobuf:"varint,7,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount     int64                  `protobuf:"varint,8,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	PostCount          int64                  `protobuf:"varint,9,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	ViewerRelationship *Relationship          `protobuf:"bytes,10,opt,name=viewer_relationship,json=viewerRelationship,proto3" json:"viewer_relationship,omitempty"`
+	Bio                string                 `protobuf:"bytes,11,opt,name=bio,proto3" json:"bio,omitempty"`
+	BioEntities        []*TextEntity          `protobuf:"bytes,12,rep,name=bio_entities,json=bioEntities,proto3" json:"bio_entities,omitempty"`
+	Links              []string               `protobuf:"bytes,13,rep,name=links,proto3" json:"links,omitempty"`
+	Pronouns           string                 `protobuf:"bytes,14,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	Location           string                 `protobuf:"bytes,15,opt,name=location,proto3" json:"location,omitempty"`
+	BannerUrl          string                 `protobuf:"bytes,16,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
 	mi := &file_user_v1_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowedEvent) String() string {
+func (x *UserProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowedEvent) ProtoMessage() {}
+func (*UserProfile) ProtoMessage() {}
 
-func (x *FollowedEvent) ProtoReflect() protoreflect.Message {
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -233,130 +347,150 @@ func (x *FollowedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowedEvent.ProtoReflect.Descriptor instead.
-func (*FollowedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *FollowedEvent) GetEventId() string {
+func (x *UserProfile) GetId() int64 {
 	if x != nil {
-		return x.EventId
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-func (x *FollowedEvent) GetUserId() int64 {
+func (x *UserProfile) GetFullName() string {
 	if x != nil {
-		return x.UserId
+		return x.FullName
 	}
-	return 0
+	return ""
 }
 
-func (x *FollowedEvent) GetFollowingId() int64 {
+func (x *UserProfile) GetProfilePicUrl() string {
 	if x != nil {
-		return x.FollowingId
+		return x.ProfilePicUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *FollowedEvent) GetFollowedAt() *timestamppb.Timestamp {
+func (x *UserProfile) GetIsVerified() bool {
 	if x != nil {
-		return x.FollowedAt
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UnfollowedEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId   int64                  `protobuf:"varint,3,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
-	UnfollowedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=unfollowed_at,json=unfollowedAt,proto3" json:"unfollowed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserProfile) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
 }
 
-func (x *UnfollowedEvent) Reset() {
-	*x = UnfollowedEvent{}
-	mi := &file_user_v1_user_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserProfile) GetFollowingCount() int64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
 }
 
-func (x *UnfollowedEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserProfile) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
 }
 
-func (*UnfollowedEvent) ProtoMessage() {}
+func (x *UserProfile) GetViewerRelationship() *Relationship {
+	if x != nil {
+		return x.ViewerRelationship
+	}
+	return nil
+}
 
-func (x *UnfollowedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[3]
+func (x *UserProfile) GetBio() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Bio
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UnfollowedEvent.ProtoReflect.Descriptor instead.
-func (*UnfollowedEvent) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
+func (x *UserProfile) GetBioEntities() []*TextEntity {
+	if x != nil {
+		return x.BioEntities
+	}
+	return nil
 }
 
-func (x *UnfollowedEvent) GetEventId() string {
+func (x *UserProfile) GetLinks() []string {
 	if x != nil {
-		return x.EventId
+		return x.Links
 	}
-	return ""
+	return nil
 }
 
-func (x *UnfollowedEvent) GetUserId() int64 {
+func (x *UserProfile) GetPronouns() string {
 	if x != nil {
-		return x.UserId
+		return x.Pronouns
 	}
-	return 0
+	return ""
 }
 
-func (x *UnfollowedEvent) GetFollowingId() int64 {
+func (x *UserProfile) GetLocation() string {
 	if x != nil {
-		return x.FollowingId
+		return x.Location
 	}
-	return 0
+	return ""
 }
 
-func (x *UnfollowedEvent) GetUnfollowedAt() *timestamppb.Timestamp {
+func (x *UserProfile) GetBannerUrl() string {
 	if x != nil {
-		return x.UnfollowedAt
+		return x.BannerUrl
 	}
-	return nil
+	return ""
 }
 
-type LoginUserRequest struct {
+// How the authenticated viewer relates to another user.
+type Relationship struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	IsSelf        bool                   `protobuf:"varint,1,opt,name=is_self,json=isSelf,proto3" json:"is_self,omitempty"`
+	Following     bool                   `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`                     // viewer follows the user
+	FollowedBy    bool                   `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"` // user follows the viewer
+	Blocking      bool                   `protobuf:"varint,4,opt,name=blocking,proto3" json:"blocking,omitempty"`                       // viewer blocked the user
+	BlockedBy     bool                   `protobuf:"varint,5,opt,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`    // user blocked the viewer
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginUserRequest) Reset() {
-	*x = LoginUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[4]
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_user_v1_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginUserRequest) String() string {
+func (x *Relationship) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginUserRequest) ProtoMessage() {}
+func (*Relationship) ProtoMessage() {}
 
-func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[4]
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,48 +501,71 @@ func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginUserRequest.ProtoReflect.Descriptor instead.
-func (*LoginUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LoginUserRequest) GetEmail() string {
+func (x *Relationship) GetIsSelf() bool {
 	if x != nil {
-		return x.Email
+		return x.IsSelf
 	}
-	return ""
+	return false
 }
 
-func (x *LoginUserRequest) GetPassword() string {
+func (x *Relationship) GetFollowing() bool {
 	if x != nil {
-		return x.Password
+		return x.Following
 	}
-	return ""
+	return false
 }
 
-type LoginUserResponse struct {
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+func (x *Relationship) GetBlockedBy() bool {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return false
+}
+
+type OutboxEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Published     bool                   `protobuf:"varint,4,opt,name=published,proto3" json:"published,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginUserResponse) Reset() {
-	*x = LoginUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[5]
+func (x *OutboxEvent) Reset() {
+	*x = OutboxEvent{}
+	mi := &file_user_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginUserResponse) String() string {
+func (x *OutboxEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginUserResponse) ProtoMessage() {}
+func (*OutboxEvent) ProtoMessage() {}
 
-func (x *LoginUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[5]
+func (x *OutboxEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,52 +576,64 @@ func (x *LoginUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginUserResponse.ProtoReflect.Descriptor instead.
-func (*LoginUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use OutboxEvent.ProtoReflect.Descriptor instead.
+func (*OutboxEvent) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LoginUserResponse) GetAccessToken() string {
+func (x *OutboxEvent) GetEventId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *LoginUserResponse) GetRefreshToken() string {
+func (x *OutboxEvent) GetEventType() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.EventType
 	}
 	return ""
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	ProfilePicUrl string                 `protobuf:"bytes,4,opt,name=profile_pic_url,json=profilePicUrl,proto3" json:"profile_pic_url,omitempty"`
-	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+func (x *OutboxEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *OutboxEvent) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+type FollowedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId   int64                  `protobuf:"varint,3,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	FollowedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[6]
+func (x *FollowedEvent) Reset() {
+	*x = FollowedEvent{}
+	mi := &file_user_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *FollowedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*FollowedEvent) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[6]
+func (x *FollowedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,74 +644,130 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use FollowedEvent.ProtoReflect.Descriptor instead.
+func (*FollowedEvent) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateUserRequest) GetUsername() string {
+func (x *FollowedEvent) GetEventId() string {
 	if x != nil {
-		return x.Username
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetFullName() string {
+func (x *FollowedEvent) GetUserId() int64 {
 	if x != nil {
-		return x.FullName
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserRequest) GetEmail() string {
+func (x *FollowedEvent) GetFollowingId() int64 {
 	if x != nil {
-		return x.Email
+		return x.FollowingId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserRequest) GetProfilePicUrl() string {
+func (x *FollowedEvent) GetFollowedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProfilePicUrl
+		return x.FollowedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserRequest) GetIsVerified() bool {
+type UnfollowedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId   int64                  `protobuf:"varint,3,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	UnfollowedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=unfollowed_at,json=unfollowedAt,proto3" json:"unfollowed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowedEvent) Reset() {
+	*x = UnfollowedEvent{}
+	mi := &file_user_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowedEvent) ProtoMessage() {}
+
+func (x *UnfollowedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	if x != nil {
-		return x.IsVerified
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequest) GetPassword() string {
+// Deprecated: Use UnfollowedEvent.ProtoReflect.Descriptor instead.
+func (*UnfollowedEvent) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnfollowedEvent) GetEventId() string {
 	if x != nil {
-		return x.Password
+		return x.EventId
 	}
 	return ""
 }
 
-type CreateUserResponse struct {
+func (x *UnfollowedEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnfollowedEvent) GetFollowingId() int64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+func (x *UnfollowedEvent) GetUnfollowedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnfollowedAt
+	}
+	return nil
+}
+
+type UserDeletedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
+func (x *UserDeletedEvent) Reset() {
+	*x = UserDeletedEvent{}
 	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *UserDeletedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*UserDeletedEvent) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *UserDeletedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,40 +779,54 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserDeletedEvent.ProtoReflect.Descriptor instead.
+func (*UserDeletedEvent) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateUserResponse) GetUser() *User {
+func (x *UserDeletedEvent) GetEventId() string {
 	if x != nil {
-		return x.User
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UserDeletedEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserDeletedEvent) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
 	}
 	return nil
 }
 
-type RefreshTokenRequest struct {
+type LoginUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshTokenRequest) Reset() {
-	*x = RefreshTokenRequest{}
+func (x *LoginUserRequest) Reset() {
+	*x = LoginUserRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenRequest) String() string {
+func (x *LoginUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenRequest) ProtoMessage() {}
+func (*LoginUserRequest) ProtoMessage() {}
 
-func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,26 +838,26 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginUserRequest.ProtoReflect.Descriptor instead.
+func (*LoginUserRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RefreshTokenRequest) GetRefreshToken() string {
+func (x *LoginUserRequest) GetEmail() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.Email
 	}
 	return ""
 }
 
-func (x *RefreshTokenRequest) GetUserId() int64 {
+func (x *LoginUserRequest) GetPassword() string {
 	if x != nil {
-		return x.UserId
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-type RefreshTokenResponse struct {
+type LoginUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -626,20 +865,20 @@ type RefreshTokenResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshTokenResponse) Reset() {
-	*x = RefreshTokenResponse{}
+func (x *LoginUserResponse) Reset() {
+	*x = LoginUserResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenResponse) String() string {
+func (x *LoginUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenResponse) ProtoMessage() {}
+func (*LoginUserResponse) ProtoMessage() {}
 
-func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *LoginUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,51 +890,1184 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
-func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginUserResponse.ProtoReflect.Descriptor instead.
+func (*LoginUserResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RefreshTokenResponse) GetAccessToken() string {
+func (x *LoginUserResponse) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *RefreshTokenResponse) GetRefreshToken() string {
+func (x *LoginUserResponse) GetRefreshToken() string {
 	if x != nil {
 		return x.RefreshToken
 	}
 	return ""
 }
 
-type UpdateUserRequest struct {
+type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	ProfilePicUrl string                 `protobuf:"bytes,5,opt,name=profile_pic_url,json=profilePicUrl,proto3" json:"profile_pic_url,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	ProfilePicUrl string                 `protobuf:"bytes,4,opt,name=profile_pic_url,json=profilePicUrl,proto3" json:"profile_pic_url,omitempty"`
+	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Bio           string                 `protobuf:"bytes,7,opt,name=bio,proto3" json:"bio,omitempty"`
+	Links         []string               `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty"`
+	Pronouns      string                 `protobuf:"bytes,9,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	Location      string                 `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	BannerUrl     string                 `protobuf:"bytes,11,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetProfilePicUrl() string {
+	if x != nil {
+		return x.ProfilePicUrl
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	ProfilePicUrl string                 `protobuf:"bytes,5,opt,name=profile_pic_url,json=profilePicUrl,proto3" json:"profile_pic_url,omitempty"`
+	Bio           string                 `protobuf:"bytes,6,opt,name=bio,proto3" json:"bio,omitempty"`
+	Links         []string               `protobuf:"bytes,7,rep,name=links,proto3" json:"links,omitempty"`
+	Pronouns      string                 `protobuf:"bytes,8,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	Location      string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	BannerUrl     string                 `protobuf:"bytes,10,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateUserRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetProfilePicUrl() string {
+	if x != nil {
+		return x.ProfilePicUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// === Get ===
+type GetUserByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIDRequest) Reset() {
+	*x = GetUserByIDRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIDRequest) ProtoMessage() {}
+
+func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByIDRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserByIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetUserByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIDResponse) Reset() {
+	*x = GetUserByIDResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIDResponse) ProtoMessage() {}
+
+func (x *GetUserByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetUserByIDResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserByIDResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// === Interest Tags ===
+type SetUserTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // replaces the caller's whole tag set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTagsRequest) Reset() {
+	*x = SetUserTagsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagsRequest) ProtoMessage() {}
+
+func (x *SetUserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetUserTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetUserTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // normalized
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTagsResponse) Reset() {
+	*x = SetUserTagsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagsResponse) ProtoMessage() {}
+
+func (x *SetUserTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetUserTagsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetUserTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetUserTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTagsRequest) Reset() {
+	*x = GetUserTagsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTagsRequest) ProtoMessage() {}
+
+func (x *GetUserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserTagsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTagsResponse) Reset() {
+	*x = GetUserTagsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTagsResponse) ProtoMessage() {}
+
+func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTagsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListUsersByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     []byte                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersByTagRequest) Reset() {
+	*x = ListUsersByTagRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersByTagRequest) ProtoMessage() {}
+
+func (x *ListUsersByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersByTagRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListUsersByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListUsersByTagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersByTagRequest) GetPageToken() []byte {
+	if x != nil {
+		return x.PageToken
+	}
+	return nil
+}
+
+type ListUsersByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersByTagResponse) Reset() {
+	*x = ListUsersByTagResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersByTagResponse) ProtoMessage() {}
+
+func (x *ListUsersByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersByTagResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersByTagResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListUsersByTagResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersByTagResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+// === Close Friends ===
+// Only the owner can read their list; members are never told they are on it.
+type AddCloseFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FriendId      int64                  `protobuf:"varint,1,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCloseFriendRequest) Reset() {
+	*x = AddCloseFriendRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCloseFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCloseFriendRequest) ProtoMessage() {}
+
+func (x *AddCloseFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*AddCloseFriendRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddCloseFriendRequest) GetFriendId() int64 {
+	if x != nil {
+		return x.FriendId
+	}
+	return 0
+}
+
+type AddCloseFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCloseFriendResponse) Reset() {
+	*x = AddCloseFriendResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCloseFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCloseFriendResponse) ProtoMessage() {}
+
+func (x *AddCloseFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*AddCloseFriendResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddCloseFriendResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveCloseFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FriendId      int64                  `protobuf:"varint,1,opt,name=friend_id,json=friendId,proto3" json:"friend_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCloseFriendRequest) Reset() {
+	*x = RemoveCloseFriendRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCloseFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCloseFriendRequest) ProtoMessage() {}
+
+func (x *RemoveCloseFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCloseFriendRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveCloseFriendRequest) GetFriendId() int64 {
+	if x != nil {
+		return x.FriendId
+	}
+	return 0
+}
+
+type RemoveCloseFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCloseFriendResponse) Reset() {
+	*x = RemoveCloseFriendResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCloseFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCloseFriendResponse) ProtoMessage() {}
+
+func (x *RemoveCloseFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCloseFriendResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveCloseFriendResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCloseFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     []byte                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCloseFriendsRequest) Reset() {
+	*x = ListCloseFriendsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCloseFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCloseFriendsRequest) ProtoMessage() {}
+
+func (x *ListCloseFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCloseFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListCloseFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCloseFriendsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCloseFriendsRequest) GetPageToken() []byte {
+	if x != nil {
+		return x.PageToken
+	}
+	return nil
+}
+
+type ListCloseFriendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCloseFriendsResponse) Reset() {
+	*x = ListCloseFriendsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCloseFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCloseFriendsResponse) ProtoMessage() {}
+
+func (x *ListCloseFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCloseFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListCloseFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCloseFriendsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListCloseFriendsResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+// === Batch Get (service-to-service hydration) ===
+type BatchGetUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUsersRequest) Reset() {
+	*x = BatchGetUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersRequest) ProtoMessage() {}
+
+func (x *BatchGetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchGetUsersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"` // unknown ids are omitted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+func (x *BatchGetUsersResponse) Reset() {
+	*x = BatchGetUsersResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *BatchGetUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*BatchGetUsersResponse) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+func (x *BatchGetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,68 +2078,85 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BatchGetUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateUserRequest) GetId() int64 {
+func (x *BatchGetUsersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Id
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateUserRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Looks up users by username, ignoring case.
+type ResolveUsernamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
+func (x *ResolveUsernamesRequest) Reset() {
+	*x = ResolveUsernamesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
+func (x *ResolveUsernamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUsernamesRequest) ProtoMessage() {}
+
+func (x *ResolveUsernamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[32]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserRequest) GetProfilePicUrl() string {
+// Deprecated: Use ResolveUsernamesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveUsernamesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResolveUsernamesRequest) GetUsernames() []string {
 	if x != nil {
-		return x.ProfilePicUrl
+		return x.Usernames
 	}
-	return ""
+	return nil
 }
 
-type UpdateUserResponse struct {
+type ResolveUsernamesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UserIds       map[string]int64       `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // keyed by the username as requested; unknown ones are omitted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+func (x *ResolveUsernamesResponse) Reset() {
+	*x = ResolveUsernamesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *ResolveUsernamesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*ResolveUsernamesResponse) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+func (x *ResolveUsernamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,41 +2167,40 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ResolveUsernamesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveUsernamesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateUserResponse) GetUser() *User {
+func (x *ResolveUsernamesResponse) GetUserIds() map[string]int64 {
 	if x != nil {
-		return x.User
+		return x.UserIds
 	}
 	return nil
 }
 
-// === Get ===
-type GetUserByIDRequest struct {
+type GetUserProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByIDRequest) Reset() {
-	*x = GetUserByIDRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByIDRequest) String() string {
+func (x *GetUserProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIDRequest) ProtoMessage() {}
+func (*GetUserProfileRequest) ProtoMessage() {}
 
-func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,40 +2211,40 @@ func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetUserByIDRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetUserByIDRequest) GetId() int64 {
+func (x *GetUserProfileRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-type GetUserByIDResponse struct {
+type GetUserProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByIDResponse) Reset() {
-	*x = GetUserByIDResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[13]
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByIDResponse) String() string {
+func (x *GetUserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIDResponse) ProtoMessage() {}
+func (*GetUserProfileResponse) ProtoMessage() {}
 
-func (x *GetUserByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[13]
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,14 +2255,14 @@ func (x *GetUserByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetUserByIDResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetUserByIDResponse) GetUser() *User {
+func (x *GetUserProfileResponse) GetProfile() *UserProfile {
 	if x != nil {
-		return x.User
+		return x.Profile
 	}
 	return nil
 }
@@ -890,7 +2278,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -902,7 +2290,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,7 +2303,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListUsersRequest) GetPageSize() int32 {
@@ -942,7 +2330,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -954,7 +2342,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,7 +2355,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -993,7 +2381,7 @@ type FollowUserRequest struct {
 
 func (x *FollowUserRequest) Reset() {
 	*x = FollowUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +2393,7 @@ func (x *FollowUserRequest) String() string {
 func (*FollowUserRequest) ProtoMessage() {}
 
 func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +2406,7 @@ func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
 func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *FollowUserRequest) GetFollowingId() int64 {
@@ -1037,7 +2425,7 @@ type FollowUserResponse struct {
 
 func (x *FollowUserResponse) Reset() {
 	*x = FollowUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +2437,7 @@ func (x *FollowUserResponse) String() string {
 func (*FollowUserResponse) ProtoMessage() {}
 
 func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,38 +2450,215 @@ func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
 func (*FollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FollowUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowingId   int64                  `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnfollowUserRequest) GetFollowingId() int64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnfollowUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Blocking removes any follow between the two users and stops new ones.
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockedId     int64                  `protobuf:"varint,1,opt,name=blocked_id,json=blockedId,proto3" json:"blocked_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BlockUserRequest) GetBlockedId() int64 {
+	if x != nil {
+		return x.BlockedId
+	}
+	return 0
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *FollowUserResponse) GetSuccess() bool {
+func (x *BlockUserResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type UnfollowUserRequest struct {
+type UnblockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FollowingId   int64                  `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	BlockedId     int64                  `protobuf:"varint,1,opt,name=blocked_id,json=blockedId,proto3" json:"blocked_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[18]
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserRequest) String() string {
+func (x *UnblockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+func (*UnblockUserRequest) ProtoMessage() {}
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[18]
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,40 +2669,40 @@ func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UnfollowUserRequest) GetFollowingId() int64 {
+func (x *UnblockUserRequest) GetBlockedId() int64 {
 	if x != nil {
-		return x.FollowingId
+		return x.BlockedId
 	}
 	return 0
 }
 
-type UnfollowUserResponse struct {
+type UnblockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[19]
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserResponse) String() string {
+func (x *UnblockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (*UnblockUserResponse) ProtoMessage() {}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[19]
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,12 +2713,12 @@ func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UnfollowUserResponse) GetSuccess() bool {
+func (x *UnblockUserResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
@@ -1170,7 +2735,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1182,7 +2747,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +2760,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DeleteUserRequest) GetId() int64 {
@@ -1214,7 +2779,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1226,7 +2791,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +2804,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DeleteUserResponse) GetSuccess() bool {
@@ -1258,7 +2823,7 @@ type IncrementFollowingAndFollowerCountRequest struct {
 
 func (x *IncrementFollowingAndFollowerCountRequest) Reset() {
 	*x = IncrementFollowingAndFollowerCountRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1270,7 +2835,7 @@ func (x *IncrementFollowingAndFollowerCountRequest) String() string {
 func (*IncrementFollowingAndFollowerCountRequest) ProtoMessage() {}
 
 func (x *IncrementFollowingAndFollowerCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,7 +2848,7 @@ func (x *IncrementFollowingAndFollowerCountRequest) ProtoReflect() protoreflect.
 
 // Deprecated: Use IncrementFollowingAndFollowerCountRequest.ProtoReflect.Descriptor instead.
 func (*IncrementFollowingAndFollowerCountRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *IncrementFollowingAndFollowerCountRequest) GetFollowedEvent() *FollowedEvent {
@@ -1302,7 +2867,7 @@ type IncrementFollowingAndFollowerCountResponse struct {
 
 func (x *IncrementFollowingAndFollowerCountResponse) Reset() {
 	*x = IncrementFollowingAndFollowerCountResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1314,7 +2879,7 @@ func (x *IncrementFollowingAndFollowerCountResponse) String() string {
 func (*IncrementFollowingAndFollowerCountResponse) ProtoMessage() {}
 
 func (x *IncrementFollowingAndFollowerCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,7 +2892,7 @@ func (x *IncrementFollowingAndFollowerCountResponse) ProtoReflect() protoreflect
 
 // Deprecated: Use IncrementFollowingAndFollowerCountResponse.ProtoReflect.Descriptor instead.
 func (*IncrementFollowingAndFollowerCountResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *IncrementFollowingAndFollowerCountResponse) GetIncremented() bool {
@@ -1346,7 +2911,7 @@ type DecrementFollowingAndFollowerCountRequest struct {
 
 func (x *DecrementFollowingAndFollowerCountRequest) Reset() {
 	*x = DecrementFollowingAndFollowerCountRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +2923,7 @@ func (x *DecrementFollowingAndFollowerCountRequest) String() string {
 func (*DecrementFollowingAndFollowerCountRequest) ProtoMessage() {}
 
 func (x *DecrementFollowingAndFollowerCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +2936,7 @@ func (x *DecrementFollowingAndFollowerCountRequest) ProtoReflect() protoreflect.
 
 // Deprecated: Use DecrementFollowingAndFollowerCountRequest.ProtoReflect.Descriptor instead.
 func (*DecrementFollowingAndFollowerCountRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *DecrementFollowingAndFollowerCountRequest) GetUnfollowedEvent() *UnfollowedEvent {
@@ -1390,7 +2955,7 @@ type DecrementFollowingAndFollowerCountResponse struct {
 
 func (x *DecrementFollowingAndFollowerCountResponse) Reset() {
 	*x = DecrementFollowingAndFollowerCountResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1402,7 +2967,7 @@ func (x *DecrementFollowingAndFollowerCountResponse) String() string {
 func (*DecrementFollowingAndFollowerCountResponse) ProtoMessage() {}
 
 func (x *DecrementFollowingAndFollowerCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,7 +2980,7 @@ func (x *DecrementFollowingAndFollowerCountResponse) ProtoReflect() protoreflect
 
 // Deprecated: Use DecrementFollowingAndFollowerCountResponse.ProtoReflect.Descriptor instead.
 func (*DecrementFollowingAndFollowerCountResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *DecrementFollowingAndFollowerCountResponse) GetDecremented() bool {
@@ -1435,7 +3000,7 @@ type FollowUserCachedRequest struct {
 
 func (x *FollowUserCachedRequest) Reset() {
 	*x = FollowUserCachedRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1447,7 +3012,7 @@ func (x *FollowUserCachedRequest) String() string {
 func (*FollowUserCachedRequest) ProtoMessage() {}
 
 func (x *FollowUserCachedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,7 +3025,7 @@ func (x *FollowUserCachedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowUserCachedRequest.ProtoReflect.Descriptor instead.
 func (*FollowUserCachedRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *FollowUserCachedRequest) GetUserId() int64 {
@@ -1486,7 +3051,7 @@ type FollowUserCachedResponse struct {
 
 func (x *FollowUserCachedResponse) Reset() {
 	*x = FollowUserCachedResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1498,7 +3063,7 @@ func (x *FollowUserCachedResponse) String() string {
 func (*FollowUserCachedResponse) ProtoMessage() {}
 
 func (x *FollowUserCachedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +3076,7 @@ func (x *FollowUserCachedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowUserCachedResponse.ProtoReflect.Descriptor instead.
 func (*FollowUserCachedResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *FollowUserCachedResponse) GetSuccess() bool {
@@ -1531,7 +3096,7 @@ type UnfollowUserCachedRequest struct {
 
 func (x *UnfollowUserCachedRequest) Reset() {
 	*x = UnfollowUserCachedRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[28]
+	mi := &file_user_v1_user_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1543,7 +3108,7 @@ func (x *UnfollowUserCachedRequest) String() string {
 func (*UnfollowUserCachedRequest) ProtoMessage() {}
 
 func (x *UnfollowUserCachedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[28]
+	mi := &file_user_v1_user_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +3121,7 @@ func (x *UnfollowUserCachedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfollowUserCachedRequest.ProtoReflect.Descriptor instead.
 func (*UnfollowUserCachedRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UnfollowUserCachedRequest) GetUserId() int64 {
@@ -1582,7 +3147,7 @@ type UnfollowUserCachedResponse struct {
 
 func (x *UnfollowUserCachedResponse) Reset() {
 	*x = UnfollowUserCachedResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[29]
+	mi := &file_user_v1_user_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1594,7 +3159,7 @@ func (x *UnfollowUserCachedResponse) String() string {
 func (*UnfollowUserCachedResponse) ProtoMessage() {}
 
 func (x *UnfollowUserCachedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[29]
+	mi := &file_user_v1_user_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +3172,7 @@ func (x *UnfollowUserCachedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfollowUserCachedResponse.ProtoReflect.Descriptor instead.
 func (*UnfollowUserCachedResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *UnfollowUserCachedResponse) GetSuccess() bool {
@@ -1626,7 +3191,7 @@ type InsertFollowerCountsRequest struct {
 
 func (x *InsertFollowerCountsRequest) Reset() {
 	*x = InsertFollowerCountsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[30]
+	mi := &file_user_v1_user_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1638,7 +3203,7 @@ func (x *InsertFollowerCountsRequest) String() string {
 func (*InsertFollowerCountsRequest) ProtoMessage() {}
 
 func (x *InsertFollowerCountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[30]
+	mi := &file_user_v1_user_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +3216,7 @@ func (x *InsertFollowerCountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertFollowerCountsRequest.ProtoReflect.Descriptor instead.
 func (*InsertFollowerCountsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *InsertFollowerCountsRequest) GetUserId() int64 {
@@ -1670,7 +3235,7 @@ type InsertFollowerCountsResponse struct {
 
 func (x *InsertFollowerCountsResponse) Reset() {
 	*x = InsertFollowerCountsResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[31]
+	mi := &file_user_v1_user_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1682,7 +3247,7 @@ func (x *InsertFollowerCountsResponse) String() string {
 func (*InsertFollowerCountsResponse) ProtoMessage() {}
 
 func (x *InsertFollowerCountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[31]
+	mi := &file_user_v1_user_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +3260,7 @@ func (x *InsertFollowerCountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertFollowerCountsResponse.ProtoReflect.Descriptor instead.
 func (*InsertFollowerCountsResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *InsertFollowerCountsResponse) GetSuccess() bool {
@@ -1705,11 +3270,256 @@ func (x *InsertFollowerCountsResponse) GetSuccess() bool {
 	return false
 }
 
+type InvalidateUserCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateUserCacheRequest) Reset() {
+	*x = InvalidateUserCacheRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateUserCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateUserCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateUserCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateUserCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateUserCacheRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *InvalidateUserCacheRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type InvalidateUserCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateUserCacheResponse) Reset() {
+	*x = InvalidateUserCacheResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateUserCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateUserCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateUserCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateUserCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateUserCacheResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InvalidateUserCacheResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// === Suggestions ===
+type FollowSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	MutualCount   int32                  `protobuf:"varint,3,opt,name=mutual_count,json=mutualCount,proto3" json:"mutual_count,omitempty"` // accounts the viewer follows that also follow this user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowSuggestion) Reset() {
+	*x = FollowSuggestion{}
+	mi := &file_user_v1_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowSuggestion) ProtoMessage() {}
+
+func (x *FollowSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowSuggestion.ProtoReflect.Descriptor instead.
+func (*FollowSuggestion) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FollowSuggestion) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FollowSuggestion) GetMutualCount() int32 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+type SuggestUsersToFollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 0 uses the default of 20; at most 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersToFollowRequest) Reset() {
+	*x = SuggestUsersToFollowRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersToFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersToFollowRequest) ProtoMessage() {}
+
+func (x *SuggestUsersToFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestUsersToFollowRequest.ProtoReflect.Descriptor instead.
+func (*SuggestUsersToFollowRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SuggestUsersToFollowRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestUsersToFollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*FollowSuggestion    `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestUsersToFollowResponse) Reset() {
+	*x = SuggestUsersToFollowResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestUsersToFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestUsersToFollowResponse) ProtoMessage() {}
+
+func (x *SuggestUsersToFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestUsersToFollowResponse.ProtoReflect.Descriptor instead.
+func (*SuggestUsersToFollowResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SuggestUsersToFollowResponse) GetSuggestions() []*FollowSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *SuggestUsersToFollowResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x02\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
@@ -1721,8 +3531,58 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
-	"\bpassword\x18\t \x01(\tR\bpassword\"\x7f\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x10\n" +
+	"\x03bio\x18\n" +
+	" \x01(\tR\x03bio\x12\x14\n" +
+	"\x05links\x18\v \x03(\tR\x05links\x12\x1a\n" +
+	"\bpronouns\x18\f \x01(\tR\bpronouns\x12\x1a\n" +
+	"\blocation\x18\r \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\x0e \x01(\tR\tbannerUrlJ\x04\b\t\x10\n" +
+	"R\bpassword\"\xf5\x01\n" +
+	"\n" +
+	"TextEntity\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.user.v1.TextEntity.TypeR\x04type\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userId\"N\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_HASHTAG\x10\x01\x12\x10\n" +
+	"\fTYPE_MENTION\x10\x02\x12\f\n" +
+	"\bTYPE_URL\x10\x03\"\xc8\x04\n" +
+	"\vUserProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12&\n" +
+	"\x0fprofile_pic_url\x18\x04 \x01(\tR\rprofilePicUrl\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0efollower_count\x18\a \x01(\x03R\rfollowerCount\x12'\n" +
+	"\x0ffollowing_count\x18\b \x01(\x03R\x0efollowingCount\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\t \x01(\x03R\tpostCount\x12F\n" +
+	"\x13viewer_relationship\x18\n" +
+	" \x01(\v2\x15.user.v1.RelationshipR\x12viewerRelationship\x12\x10\n" +
+	"\x03bio\x18\v \x01(\tR\x03bio\x126\n" +
+	"\fbio_entities\x18\f \x03(\v2\x13.user.v1.TextEntityR\vbioEntities\x12\x14\n" +
+	"\x05links\x18\r \x03(\tR\x05links\x12\x1a\n" +
+	"\bpronouns\x18\x0e \x01(\tR\bpronouns\x12\x1a\n" +
+	"\blocation\x18\x0f \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\x10 \x01(\tR\tbannerUrl\"\xa1\x01\n" +
+	"\fRelationship\x12\x17\n" +
+	"\ais_self\x18\x01 \x01(\bR\x06isSelf\x12\x1c\n" +
+	"\tfollowing\x18\x02 \x01(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x03 \x01(\bR\n" +
+	"followedBy\x12\x1a\n" +
+	"\bblocking\x18\x04 \x01(\bR\bblocking\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\x05 \x01(\bR\tblockedBy\"\x7f\n" +
 	"\vOutboxEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -1739,13 +3599,18 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12!\n" +
 	"\ffollowing_id\x18\x03 \x01(\x03R\vfollowingId\x12?\n" +
-	"\runfollowed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\funfollowedAt\"D\n" +
+	"\runfollowed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\funfollowedAt\"\x81\x01\n" +
+	"\x10UserDeletedEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"D\n" +
 	"\x10LoginUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"[\n" +
 	"\x11LoginUserResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\xc7\x01\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\xc6\x02\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
@@ -1753,7 +3618,14 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x0fprofile_pic_url\x18\x04 \x01(\tR\rprofilePicUrl\x12\x1f\n" +
 	"\vis_verified\x18\x05 \x01(\bR\n" +
 	"isVerified\x12\x1a\n" +
-	"\bpassword\x18\x06 \x01(\tR\bpassword\"7\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\x12\x10\n" +
+	"\x03bio\x18\a \x01(\tR\x03bio\x12\x14\n" +
+	"\x05links\x18\b \x03(\tR\x05links\x12\x1a\n" +
+	"\bpronouns\x18\t \x01(\tR\bpronouns\x12\x1a\n" +
+	"\blocation\x18\n" +
+	" \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\v \x01(\tR\tbannerUrl\"7\n" +
 	"\x12CreateUserResponse\x12!\n" +
 	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"S\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
@@ -1761,19 +3633,72 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\"^\n" +
 	"\x14RefreshTokenResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x9a\x01\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x99\x02\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
 	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12&\n" +
-	"\x0fprofile_pic_url\x18\x05 \x01(\tR\rprofilePicUrl\"7\n" +
+	"\x0fprofile_pic_url\x18\x05 \x01(\tR\rprofilePicUrl\x12\x10\n" +
+	"\x03bio\x18\x06 \x01(\tR\x03bio\x12\x14\n" +
+	"\x05links\x18\a \x03(\tR\x05links\x12\x1a\n" +
+	"\bpronouns\x18\b \x01(\tR\bpronouns\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\n" +
+	" \x01(\tR\tbannerUrl\"7\n" +
 	"\x12UpdateUserResponse\x12!\n" +
 	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"$\n" +
 	"\x12GetUserByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
 	"\x13GetUserByIDResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"N\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"(\n" +
+	"\x12SetUserTagsRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\")\n" +
+	"\x13SetUserTagsResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"-\n" +
+	"\x12GetUserTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\")\n" +
+	"\x13GetUserTagsResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"e\n" +
+	"\x15ListUsersByTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\fR\tpageToken\"e\n" +
+	"\x16ListUsersByTagResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"4\n" +
+	"\x15AddCloseFriendRequest\x12\x1b\n" +
+	"\tfriend_id\x18\x01 \x01(\x03R\bfriendId\"2\n" +
+	"\x16AddCloseFriendResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x18RemoveCloseFriendRequest\x12\x1b\n" +
+	"\tfriend_id\x18\x01 \x01(\x03R\bfriendId\"5\n" +
+	"\x19RemoveCloseFriendResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x17ListCloseFriendsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\fR\tpageToken\"g\n" +
+	"\x18ListCloseFriendsResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"(\n" +
+	"\x14BatchGetUsersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"<\n" +
+	"\x15BatchGetUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\"7\n" +
+	"\x17ResolveUsernamesRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"\xa1\x01\n" +
+	"\x18ResolveUsernamesResponse\x12I\n" +
+	"\buser_ids\x18\x01 \x03(\v2..user.v1.ResolveUsernamesResponse.UserIdsEntryR\auserIds\x1a:\n" +
+	"\fUserIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"0\n" +
+	"\x15GetUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"H\n" +
+	"\x16GetUserProfileResponse\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.user.v1.UserProfileR\aprofile\"N\n" +
 	"\x10ListUsersRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -1788,6 +3713,16 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x13UnfollowUserRequest\x12!\n" +
 	"\ffollowing_id\x18\x02 \x01(\x03R\vfollowingId\"0\n" +
 	"\x14UnfollowUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
+	"\x10BlockUserRequest\x12\x1d\n" +
+	"\n" +
+	"blocked_id\x18\x01 \x01(\x03R\tblockedId\"-\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"3\n" +
+	"\x12UnblockUserRequest\x12\x1d\n" +
+	"\n" +
+	"blocked_id\x18\x01 \x01(\x03R\tblockedId\"/\n" +
+	"\x13UnblockUserResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"#\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
@@ -1814,7 +3749,21 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x1bInsertFollowerCountsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"8\n" +
 	"\x1cInsertFollowerCountsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xd2\t\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
+	"\x1aInvalidateUserCacheRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"7\n" +
+	"\x1bInvalidateUserCacheResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"d\n" +
+	"\x10FollowSuggestion\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12!\n" +
+	"\fmutual_count\x18\x03 \x01(\x05R\vmutualCount\"3\n" +
+	"\x1bSuggestUsersToFollowRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\x98\x01\n" +
+	"\x1cSuggestUsersToFollowResponse\x12;\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x19.user.v1.FollowSuggestionR\vsuggestions\x12;\n" +
+	"\vcomputed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt2\x92\x12\n" +
 	"\vUserService\x12B\n" +
 	"\tLoginUser\x12\x19.user.v1.LoginUserRequest\x1a\x1a.user.v1.LoginUserResponse\x12E\n" +
 	"\n" +
@@ -1824,16 +3773,29 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\x1b.user.v1.UpdateUserResponse\x12E\n" +
 	"\n" +
 	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\x12H\n" +
-	"\vGetUserByID\x12\x1b.user.v1.GetUserByIDRequest\x1a\x1c.user.v1.GetUserByIDResponse\x12B\n" +
+	"\vGetUserByID\x12\x1b.user.v1.GetUserByIDRequest\x1a\x1c.user.v1.GetUserByIDResponse\x12Q\n" +
+	"\x0eGetUserProfile\x12\x1e.user.v1.GetUserProfileRequest\x1a\x1f.user.v1.GetUserProfileResponse\x12N\n" +
+	"\rBatchGetUsers\x12\x1d.user.v1.BatchGetUsersRequest\x1a\x1e.user.v1.BatchGetUsersResponse\x12W\n" +
+	"\x10ResolveUsernames\x12 .user.v1.ResolveUsernamesRequest\x1a!.user.v1.ResolveUsernamesResponse\x12Q\n" +
+	"\x0eAddCloseFriend\x12\x1e.user.v1.AddCloseFriendRequest\x1a\x1f.user.v1.AddCloseFriendResponse\x12Z\n" +
+	"\x11RemoveCloseFriend\x12!.user.v1.RemoveCloseFriendRequest\x1a\".user.v1.RemoveCloseFriendResponse\x12W\n" +
+	"\x10ListCloseFriends\x12 .user.v1.ListCloseFriendsRequest\x1a!.user.v1.ListCloseFriendsResponse\x12H\n" +
+	"\vSetUserTags\x12\x1b.user.v1.SetUserTagsRequest\x1a\x1c.user.v1.SetUserTagsResponse\x12H\n" +
+	"\vGetUserTags\x12\x1b.user.v1.GetUserTagsRequest\x1a\x1c.user.v1.GetUserTagsResponse\x12Q\n" +
+	"\x0eListUsersByTag\x12\x1e.user.v1.ListUsersByTagRequest\x1a\x1f.user.v1.ListUsersByTagResponse\x12B\n" +
 	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\x12E\n" +
 	"\n" +
 	"FollowUser\x12\x1a.user.v1.FollowUserRequest\x1a\x1b.user.v1.FollowUserResponse\x12K\n" +
-	"\fUnfollowUser\x12\x1c.user.v1.UnfollowUserRequest\x1a\x1d.user.v1.UnfollowUserResponse\x12\x8d\x01\n" +
+	"\fUnfollowUser\x12\x1c.user.v1.UnfollowUserRequest\x1a\x1d.user.v1.UnfollowUserResponse\x12B\n" +
+	"\tBlockUser\x12\x19.user.v1.BlockUserRequest\x1a\x1a.user.v1.BlockUserResponse\x12H\n" +
+	"\vUnblockUser\x12\x1b.user.v1.UnblockUserRequest\x1a\x1c.user.v1.UnblockUserResponse\x12\x8d\x01\n" +
 	"\"IncrementFollowingAndFollowerCount\x122.user.v1.IncrementFollowingAndFollowerCountRequest\x1a3.user.v1.IncrementFollowingAndFollowerCountResponse\x12\x8d\x01\n" +
 	"\"DecrementFollowingAndFollowerCount\x122.user.v1.DecrementFollowingAndFollowerCountRequest\x1a3.user.v1.DecrementFollowingAndFollowerCountResponse\x12W\n" +
 	"\x10FollowUserCached\x12 .user.v1.FollowUserCachedRequest\x1a!.user.v1.FollowUserCachedResponse\x12]\n" +
 	"\x12UnfollowUserCached\x12\".user.v1.UnfollowUserCachedRequest\x1a#.user.v1.UnfollowUserCachedResponse\x12c\n" +
-	"\x14InsertFollowerCounts\x12$.user.v1.InsertFollowerCountsRequest\x1a%.user.v1.InsertFollowerCountsResponseB\x94\x01\n" +
+	"\x14InsertFollowerCounts\x12$.user.v1.InsertFollowerCountsRequest\x1a%.user.v1.InsertFollowerCountsResponse\x12`\n" +
+	"\x13InvalidateUserCache\x12#.user.v1.InvalidateUserCacheRequest\x1a$.user.v1.InvalidateUserCacheResponse\x12c\n" +
+	"\x14SuggestUsersToFollow\x12$.user.v1.SuggestUsersToFollowRequest\x1a%.user.v1.SuggestUsersToFollowResponseB\x94\x01\n" +
 	"\vcom.user.v1B\tUserProtoP\x01Z=github.com/yaninyzwitty/threads-go-backend/gen/user/v1;userv1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
 
 var (
@@ -1848,86 +3810,158 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_user_v1_user_proto_goTypes = []any{
-	(*User)(nil),                                       // 0: user.v1.User
-	(*OutboxEvent)(nil),                                // 1: user.v1.OutboxEvent
-	(*FollowedEvent)(nil),                              // 2: user.v1.FollowedEvent
-	(*UnfollowedEvent)(nil),                            // 3: user.v1.UnfollowedEvent
-	(*LoginUserRequest)(nil),                           // 4: user.v1.LoginUserRequest
-	(*LoginUserResponse)(nil),                          // 5: user.v1.LoginUserResponse
-	(*CreateUserRequest)(nil),                          // 6: user.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),                         // 7: user.v1.CreateUserResponse
-	(*RefreshTokenRequest)(nil),                        // 8: user.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),                       // 9: user.v1.RefreshTokenResponse
-	(*UpdateUserRequest)(nil),                          // 10: user.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),                         // 11: user.v1.UpdateUserResponse
-	(*GetUserByIDRequest)(nil),                         // 12: user.v1.GetUserByIDRequest
-	(*GetUserByIDResponse)(nil),                        // 13: user.v1.GetUserByIDResponse
-	(*ListUsersRequest)(nil),                           // 14: user.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                          // 15: user.v1.ListUsersResponse
-	(*FollowUserRequest)(nil),                          // 16: user.v1.FollowUserRequest
-	(*FollowUserResponse)(nil),                         // 17: user.v1.FollowUserResponse
-	(*UnfollowUserRequest)(nil),                        // 18: user.v1.UnfollowUserRequest
-	(*UnfollowUserResponse)(nil),                       // 19: user.v1.UnfollowUserResponse
-	(*DeleteUserRequest)(nil),                          // 20: user.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                         // 21: user.v1.DeleteUserResponse
-	(*IncrementFollowingAndFollowerCountRequest)(nil),  // 22: user.v1.IncrementFollowingAndFollowerCountRequest
-	(*IncrementFollowingAndFollowerCountResponse)(nil), // 23: user.v1.IncrementFollowingAndFollowerCountResponse
-	(*DecrementFollowingAndFollowerCountRequest)(nil),  // 24: user.v1.DecrementFollowingAndFollowerCountRequest
-	(*DecrementFollowingAndFollowerCountResponse)(nil), // 25: user.v1.DecrementFollowingAndFollowerCountResponse
-	(*FollowUserCachedRequest)(nil),                    // 26: user.v1.FollowUserCachedRequest
-	(*FollowUserCachedResponse)(nil),                   // 27: user.v1.FollowUserCachedResponse
-	(*UnfollowUserCachedRequest)(nil),                  // 28: user.v1.UnfollowUserCachedRequest
-	(*UnfollowUserCachedResponse)(nil),                 // 29: user.v1.UnfollowUserCachedResponse
-	(*InsertFollowerCountsRequest)(nil),                // 30: user.v1.InsertFollowerCountsRequest
-	(*InsertFollowerCountsResponse)(nil),               // 31: user.v1.InsertFollowerCountsResponse
-	(*timestamppb.Timestamp)(nil),                      // 32: google.protobuf.Timestamp
+	(TextEntity_Type)(0),                               // 0: user.v1.TextEntity.Type
+	(*User)(nil),                                       // 1: user.v1.User
+	(*TextEntity)(nil),                                 // 2: user.v1.TextEntity
+	(*UserProfile)(nil),                                // 3: user.v1.UserProfile
+	(*Relationship)(nil),                               // 4: user.v1.Relationship
+	(*OutboxEvent)(nil),                                // 5: user.v1.OutboxEvent
+	(*FollowedEvent)(nil),                              // 6: user.v1.FollowedEvent
+	(*UnfollowedEvent)(nil),                            // 7: user.v1.UnfollowedEvent
+	(*UserDeletedEvent)(nil),                           // 8: user.v1.UserDeletedEvent
+	(*LoginUserRequest)(nil),                           // 9: user.v1.LoginUserRequest
+	(*LoginUserResponse)(nil),                          // 10: user.v1.LoginUserResponse
+	(*CreateUserRequest)(nil),                          // 11: user.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                         // 12: user.v1.CreateUserResponse
+	(*RefreshTokenRequest)(nil),                        // 13: user.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                       // 14: user.v1.RefreshTokenResponse
+	(*UpdateUserRequest)(nil),                          // 15: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                         // 16: user.v1.UpdateUserResponse
+	(*GetUserByIDRequest)(nil),                         // 17: user.v1.GetUserByIDRequest
+	(*GetUserByIDResponse)(nil),                        // 18: user.v1.GetUserByIDResponse
+	(*SetUserTagsRequest)(nil),                         // 19: user.v1.SetUserTagsRequest
+	(*SetUserTagsResponse)(nil),                        // 20: user.v1.SetUserTagsResponse
+	(*GetUserTagsRequest)(nil),                         // 21: user.v1.GetUserTagsRequest
+	(*GetUserTagsResponse)(nil),                        // 22: user.v1.GetUserTagsResponse
+	(*ListUsersByTagRequest)(nil),                      // 23: user.v1.ListUsersByTagRequest
+	(*ListUsersByTagResponse)(nil),                     // 24: user.v1.ListUsersByTagResponse
+	(*AddCloseFriendRequest)(nil),                      // 25: user.v1.AddCloseFriendRequest
+	(*AddCloseFriendResponse)(nil),                     // 26: user.v1.AddCloseFriendResponse
+	(*RemoveCloseFriendRequest)(nil),                   // 27: user.v1.RemoveCloseFriendRequest
+	(*RemoveCloseFriendResponse)(nil),                  // 28: user.v1.RemoveCloseFriendResponse
+	(*ListCloseFriendsRequest)(nil),                    // 29: user.v1.ListCloseFriendsRequest
+	(*ListCloseFriendsResponse)(nil),                   // 30: user.v1.ListCloseFriendsResponse
+	(*BatchGetUsersRequest)(nil),                       // 31: user.v1.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),                      // 32: user.v1.BatchGetUsersResponse
+	(*ResolveUsernamesRequest)(nil),                    // 33: user.v1.ResolveUsernamesRequest
+	(*ResolveUsernamesResponse)(nil),                   // 34: user.v1.ResolveUsernamesResponse
+	(*GetUserProfileRequest)(nil),                      // 35: user.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),                     // 36: user.v1.GetUserProfileResponse
+	(*ListUsersRequest)(nil),                           // 37: user.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                          // 38: user.v1.ListUsersResponse
+	(*FollowUserRequest)(nil),                          // 39: user.v1.FollowUserRequest
+	(*FollowUserResponse)(nil),                         // 40: user.v1.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                        // 41: user.v1.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),                       // 42: user.v1.UnfollowUserResponse
+	(*BlockUserRequest)(nil),                           // 43: user.v1.BlockUserRequest
+	(*BlockUserResponse)(nil),                          // 44: user.v1.BlockUserResponse
+	(*UnblockUserRequest)(nil),                         // 45: user.v1.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                        // 46: user.v1.UnblockUserResponse
+	(*DeleteUserRequest)(nil),                          // 47: user.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                         // 48: user.v1.DeleteUserResponse
+	(*IncrementFollowingAndFollowerCountRequest)(nil),  // 49: user.v1.IncrementFollowingAndFollowerCountRequest
+	(*IncrementFollowingAndFollowerCountResponse)(nil), // 50: user.v1.IncrementFollowingAndFollowerCountResponse
+	(*DecrementFollowingAndFollowerCountRequest)(nil),  // 51: user.v1.DecrementFollowingAndFollowerCountRequest
+	(*DecrementFollowingAndFollowerCountResponse)(nil), // 52: user.v1.DecrementFollowingAndFollowerCountResponse
+	(*FollowUserCachedRequest)(nil),                    // 53: user.v1.FollowUserCachedRequest
+	(*FollowUserCachedResponse)(nil),                   // 54: user.v1.FollowUserCachedResponse
+	(*UnfollowUserCachedRequest)(nil),                  // 55: user.v1.UnfollowUserCachedRequest
+	(*UnfollowUserCachedResponse)(nil),                 // 56: user.v1.UnfollowUserCachedResponse
+	(*InsertFollowerCountsRequest)(nil),                // 57: user.v1.InsertFollowerCountsRequest
+	(*InsertFollowerCountsResponse)(nil),               // 58: user.v1.InsertFollowerCountsResponse
+	(*InvalidateUserCacheRequest)(nil),                 // 59: user.v1.InvalidateUserCacheRequest
+	(*InvalidateUserCacheResponse)(nil),                // 60: user.v1.InvalidateUserCacheResponse
+	(*FollowSuggestion)(nil),                           // 61: user.v1.FollowSuggestion
+	(*SuggestUsersToFollowRequest)(nil),                // 62: user.v1.SuggestUsersToFollowRequest
+	(*SuggestUsersToFollowResponse)(nil),               // 63: user.v1.SuggestUsersToFollowResponse
+	nil,                                                // 64: user.v1.ResolveUsernamesResponse.UserIdsEntry
+	(*timestamppb.Timestamp)(nil),                      // 65: google.protobuf.Timestamp
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	32, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	32, // 1: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	32, // 2: user.v1.FollowedEvent.followed_at:type_name -> google.protobuf.Timestamp
-	32, // 3: user.v1.UnfollowedEvent.unfollowed_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: user.v1.CreateUserResponse.user:type_name -> user.v1.User
-	0,  // 5: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
-	0,  // 6: user.v1.GetUserByIDResponse.user:type_name -> user.v1.User
-	0,  // 7: user.v1.ListUsersResponse.users:type_name -> user.v1.User
-	2,  // 8: user.v1.IncrementFollowingAndFollowerCountRequest.followed_event:type_name -> user.v1.FollowedEvent
-	3,  // 9: user.v1.DecrementFollowingAndFollowerCountRequest.unfollowed_event:type_name -> user.v1.UnfollowedEvent
-	4,  // 10: user.v1.UserService.LoginUser:input_type -> user.v1.LoginUserRequest
-	6,  // 11: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
-	8,  // 12: user.v1.UserService.RefreshToken:input_type -> user.v1.RefreshTokenRequest
-	10, // 13: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	20, // 14: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
-	12, // 15: user.v1.UserService.GetUserByID:input_type -> user.v1.GetUserByIDRequest
-	14, // 16: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
-	16, // 17: user.v1.UserService.FollowUser:input_type -> user.v1.FollowUserRequest
-	18, // 18: user.v1.UserService.UnfollowUser:input_type -> user.v1.UnfollowUserRequest
-	22, // 19: user.v1.UserService.IncrementFollowingAndFollowerCount:input_type -> user.v1.IncrementFollowingAndFollowerCountRequest
-	24, // 20: user.v1.UserService.DecrementFollowingAndFollowerCount:input_type -> user.v1.DecrementFollowingAndFollowerCountRequest
-	26, // 21: user.v1.UserService.FollowUserCached:input_type -> user.v1.FollowUserCachedRequest
-	28, // 22: user.v1.UserService.UnfollowUserCached:input_type -> user.v1.UnfollowUserCachedRequest
-	30, // 23: user.v1.UserService.InsertFollowerCounts:input_type -> user.v1.InsertFollowerCountsRequest
-	5,  // 24: user.v1.UserService.LoginUser:output_type -> user.v1.LoginUserResponse
-	7,  // 25: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	9,  // 26: user.v1.UserService.RefreshToken:output_type -> user.v1.RefreshTokenResponse
-	11, // 27: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	21, // 28: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
-	13, // 29: user.v1.UserService.GetUserByID:output_type -> user.v1.GetUserByIDResponse
-	15, // 30: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	17, // 31: user.v1.UserService.FollowUser:output_type -> user.v1.FollowUserResponse
-	19, // 32: user.v1.UserService.UnfollowUser:output_type -> user.v1.UnfollowUserResponse
-	23, // 33: user.v1.UserService.IncrementFollowingAndFollowerCount:output_type -> user.v1.IncrementFollowingAndFollowerCountResponse
-	25, // 34: user.v1.UserService.DecrementFollowingAndFollowerCount:output_type -> user.v1.DecrementFollowingAndFollowerCountResponse
-	27, // 35: user.v1.UserService.FollowUserCached:output_type -> user.v1.FollowUserCachedResponse
-	29, // 36: user.v1.UserService.UnfollowUserCached:output_type -> user.v1.UnfollowUserCachedResponse
-	31, // 37: user.v1.UserService.InsertFollowerCounts:output_type -> user.v1.InsertFollowerCountsResponse
-	24, // [24:38] is the sub-list for method output_type
-	10, // [10:24] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	65, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	65, // 1: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: user.v1.TextEntity.type:type_name -> user.v1.TextEntity.Type
+	65, // 3: user.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: user.v1.UserProfile.viewer_relationship:type_name -> user.v1.Relationship
+	2,  // 5: user.v1.UserProfile.bio_entities:type_name -> user.v1.TextEntity
+	65, // 6: user.v1.FollowedEvent.followed_at:type_name -> google.protobuf.Timestamp
+	65, // 7: user.v1.UnfollowedEvent.unfollowed_at:type_name -> google.protobuf.Timestamp
+	65, // 8: user.v1.UserDeletedEvent.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 9: user.v1.CreateUserResponse.user:type_name -> user.v1.User
+	1,  // 10: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
+	1,  // 11: user.v1.GetUserByIDResponse.user:type_name -> user.v1.User
+	1,  // 12: user.v1.ListUsersByTagResponse.users:type_name -> user.v1.User
+	1,  // 13: user.v1.ListCloseFriendsResponse.users:type_name -> user.v1.User
+	1,  // 14: user.v1.BatchGetUsersResponse.users:type_name -> user.v1.User
+	64, // 15: user.v1.ResolveUsernamesResponse.user_ids:type_name -> user.v1.ResolveUsernamesResponse.UserIdsEntry
+	3,  // 16: user.v1.GetUserProfileResponse.profile:type_name -> user.v1.UserProfile
+	1,  // 17: user.v1.ListUsersResponse.users:type_name -> user.v1.User
+	6,  // 18: user.v1.IncrementFollowingAndFollowerCountRequest.followed_event:type_name -> user.v1.FollowedEvent
+	7,  // 19: user.v1.DecrementFollowingAndFollowerCountRequest.unfollowed_event:type_name -> user.v1.UnfollowedEvent
+	61, // 20: user.v1.SuggestUsersToFollowResponse.suggestions:type_name -> user.v1.FollowSuggestion
+	65, // 21: user.v1.SuggestUsersToFollowResponse.computed_at:type_name -> google.protobuf.Timestamp
+	9,  // 22: user.v1.UserService.LoginUser:input_type -> user.v1.LoginUserRequest
+	11, // 23: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	13, // 24: user.v1.UserService.RefreshToken:input_type -> user.v1.RefreshTokenRequest
+	15, // 25: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	47, // 26: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
+	17, // 27: user.v1.UserService.GetUserByID:input_type -> user.v1.GetUserByIDRequest
+	35, // 28: user.v1.UserService.GetUserProfile:input_type -> user.v1.GetUserProfileRequest
+	31, // 29: user.v1.UserService.BatchGetUsers:input_type -> user.v1.BatchGetUsersRequest
+	33, // 30: user.v1.UserService.ResolveUsernames:input_type -> user.v1.ResolveUsernamesRequest
+	25, // 31: user.v1.UserService.AddCloseFriend:input_type -> user.v1.AddCloseFriendRequest
+	27, // 32: user.v1.UserService.RemoveCloseFriend:input_type -> user.v1.RemoveCloseFriendRequest
+	29, // 33: user.v1.UserService.ListCloseFriends:input_type -> user.v1.ListCloseFriendsRequest
+	19, // 34: user.v1.UserService.SetUserTags:input_type -> user.v1.SetUserTagsRequest
+	21, // 35: user.v1.UserService.GetUserTags:input_type -> user.v1.GetUserTagsRequest
+	23, // 36: user.v1.UserService.ListUsersByTag:input_type -> user.v1.ListUsersByTagRequest
+	37, // 37: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	39, // 38: user.v1.UserService.FollowUser:input_type -> user.v1.FollowUserRequest
+	41, // 39: user.v1.UserService.UnfollowUser:input_type -> user.v1.UnfollowUserRequest
+	43, // 40: user.v1.UserService.BlockUser:input_type -> user.v1.BlockUserRequest
+	45, // 41: user.v1.UserService.UnblockUser:input_type -> user.v1.UnblockUserRequest
+	49, // 42: user.v1.UserService.IncrementFollowingAndFollowerCount:input_type -> user.v1.IncrementFollowingAndFollowerCountRequest
+	51, // 43: user.v1.UserService.DecrementFollowingAndFollowerCount:input_type -> user.v1.DecrementFollowingAndFollowerCountRequest
+	53, // 44: user.v1.UserService.FollowUserCached:input_type -> user.v1.FollowUserCachedRequest
+	55, // 45: user.v1.UserService.UnfollowUserCached:input_type -> user.v1.UnfollowUserCachedRequest
+	57, // 46: user.v1.UserService.InsertFollowerCounts:input_type -> user.v1.InsertFollowerCountsRequest
+	59, // 47: user.v1.UserService.InvalidateUserCache:input_type -> user.v1.InvalidateUserCacheRequest
+	62, // 48: user.v1.UserService.SuggestUsersToFollow:input_type -> user.v1.SuggestUsersToFollowRequest
+	10, // 49: user.v1.UserService.LoginUser:output_type -> user.v1.LoginUserResponse
+	12, // 50: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	14, // 51: user.v1.UserService.RefreshToken:output_type -> user.v1.RefreshTokenResponse
+	16, // 52: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	48, // 53: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
+	18, // 54: user.v1.UserService.GetUserByID:output_type -> user.v1.GetUserByIDResponse
+	36, // 55: user.v1.UserService.GetUserProfile:output_type -> user.v1.GetUserProfileResponse
+	32, // 56: user.v1.UserService.BatchGetUsers:output_type -> user.v1.BatchGetUsersResponse
+	34, // 57: user.v1.UserService.ResolveUsernames:output_type -> user.v1.ResolveUsernamesResponse
+	26, // 58: user.v1.UserService.AddCloseFriend:output_type -> user.v1.AddCloseFriendResponse
+	28, // 59: user.v1.UserService.RemoveCloseFriend:output_type -> user.v1.RemoveCloseFriendResponse
+	30, // 60: user.v1.UserService.ListCloseFriends:output_type -> user.v1.ListCloseFriendsResponse
+	20, // 61: user.v1.UserService.SetUserTags:output_type -> user.v1.SetUserTagsResponse
+	22, // 62: user.v1.UserService.GetUserTags:output_type -> user.v1.GetUserTagsResponse
+	24, // 63: user.v1.UserService.ListUsersByTag:output_type -> user.v1.ListUsersByTagResponse
+	38, // 64: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	40, // 65: user.v1.UserService.FollowUser:output_type -> user.v1.FollowUserResponse
+	42, // 66: user.v1.UserService.UnfollowUser:output_type -> user.v1.UnfollowUserResponse
+	44, // 67: user.v1.UserService.BlockUser:output_type -> user.v1.BlockUserResponse
+	46, // 68: user.v1.UserService.UnblockUser:output_type -> user.v1.UnblockUserResponse
+	50, // 69: user.v1.UserService.IncrementFollowingAndFollowerCount:output_type -> user.v1.IncrementFollowingAndFollowerCountResponse
+	52, // 70: user.v1.UserService.DecrementFollowingAndFollowerCount:output_type -> user.v1.DecrementFollowingAndFollowerCountResponse
+	54, // 71: user.v1.UserService.FollowUserCached:output_type -> user.v1.FollowUserCachedResponse
+	56, // 72: user.v1.UserService.UnfollowUserCached:output_type -> user.v1.UnfollowUserCachedResponse
+	58, // 73: user.v1.UserService.InsertFollowerCounts:output_type -> user.v1.InsertFollowerCountsResponse
+	60, // 74: user.v1.UserService.InvalidateUserCache:output_type -> user.v1.InvalidateUserCacheResponse
+	63, // 75: user.v1.UserService.SuggestUsersToFollow:output_type -> user.v1.SuggestUsersToFollowResponse
+	49, // [49:76] is the sub-list for method output_type
+	22, // [22:49] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -1940,13 +3974,14 @@ func file_user_v1_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   32,
+			NumEnums:      1,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_v1_user_proto_goTypes,
 		DependencyIndexes: file_user_v1_user_proto_depIdxs,
+		EnumInfos:         file_user_v1_user_proto_enumTypes,
 		MessageInfos:      file_user_v1_user_proto_msgTypes,
 	}.Build()
 	File_user_v1_user_proto = out.File
@@ -46,6 +46,31 @@ const (
 	UserServiceDeleteUserProcedure = "/user.v1.UserService/DeleteUser"
 	// UserServiceGetUserByIDProcedure is the fully-qualified name of the UserService's GetUserByID RPC.
 	UserServiceGetUserByIDProcedure = "/user.v1.UserService/GetUserByID"
+	// UserServiceGetUserProfileProcedure is the fully-qualified name of the UserService's
+	// GetUserProfile RPC.
+	UserServiceGetUserProfileProcedure = "/user.v1.UserService/GetUserProfile"
+	// UserServiceBatchGetUsersProcedure is the fully-qualified name of the UserService's BatchGetUsers
+	// RPC.
+	UserServiceBatchGetUsersProcedure = "/user.v1.UserService/BatchGetUsers"
+	// UserServiceResolveUsernamesProcedure is the fully-qualified name of the UserService's
+	// ResolveUsernames RPC.
+	UserServiceResolveUsernamesProcedure = "/user.v1.UserService/ResolveUsernames"
+	// UserServiceAddCloseFriendProcedure is the fully-qualified name of the UserService's
+	// AddCloseFriend RPC.
+	UserServiceAddCloseFriendProcedure = "/user.v1.UserService/AddCloseFriend"
+	// UserServiceRemoveCloseFriendProcedure is the fully-qualified name of the UserService's
+	// RemoveCloseFriend RPC.
+	UserServiceRemoveCloseFriendProcedure = "/user.v1.UserService/RemoveCloseFriend"
+	// UserServiceListCloseFriendsProcedure is the fully-qualified name of the UserService's
+	// ListCloseFriends RPC.
+	UserServiceListCloseFriendsProcedure = "/user.v1.UserService/ListCloseFriends"
+	// UserServiceSetUserTagsProcedure is the fully-qualified name of the UserService's SetUserTags RPC.
+	UserServiceSetUserTagsProcedure = "/user.v1.UserService/SetUserTags"
+	// UserServiceGetUserTagsProcedure is the fully-qualified name of the UserService's GetUserTags RPC.
+	UserServiceGetUserTagsProcedure = "/user.v1.UserService/GetUserTags"
+	// UserServiceListUsersByTagProcedure is the fully-qualified name of the UserService's
+	// ListUsersByTag RPC.
+	UserServiceListUsersByTagProcedure = "/user.v1.UserService/ListUsersByTag"
 	// UserServiceListUsersProcedure is the fully-qualified name of the UserService's ListUsers RPC.
 	UserServiceListUsersProcedure = "/user.v1.UserService/ListUsers"
 	// UserServiceFollowUserProcedure is the fully-qualified name of the UserService's FollowUser RPC.
@@ -53,6 +78,10 @@ const (
 	// UserServiceUnfollowUserProcedure is the fully-qualified name of the UserService's UnfollowUser
 	// RPC.
 	UserServiceUnfollowUserProcedure = "/user.v1.UserService/UnfollowUser"
+	// UserServiceBlockUserProcedure is the fully-qualified name of the UserService's BlockUser RPC.
+	UserServiceBlockUserProcedure = "/user.v1.UserService/BlockUser"
+	// UserServiceUnblockUserProcedure is the fully-qualified name of the UserService's UnblockUser RPC.
+	UserServiceUnblockUserProcedure = "/user.v1.UserService/UnblockUser"
 	// UserServiceIncrementFollowingAndFollowerCountProcedure is the fully-qualified name of the
 	// UserService's IncrementFollowingAndFollowerCount RPC.
 	UserServiceIncrementFollowingAndFollowerCountProcedure = "/user.v1.UserService/IncrementFollowingAndFollowerCount"
@@ -68,6 +97,12 @@ const (
 	// UserServiceInsertFollowerCountsProcedure is the fully-qualified name of the UserService's
 	// InsertFollowerCounts RPC.
 	UserServiceInsertFollowerCountsProcedure = "/user.v1.UserService/InsertFollowerCounts"
+	// UserServiceInvalidateUserCacheProcedure is the fully-qualified name of the UserService's
+	// InvalidateUserCache RPC.
+	UserServiceInvalidateUserCacheProcedure = "/user.v1.UserService/InvalidateUserCache"
+	// UserServiceSuggestUsersToFollowProcedure is the fully-qualified name of the UserService's
+	// SuggestUsersToFollow RPC.
+	UserServiceSuggestUsersToFollowProcedure = "/user.v1.UserService/SuggestUsersToFollow"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
@@ -78,14 +113,27 @@ type UserServiceClient interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	GetUserByID(context.Context, *connect.Request[v1.GetUserByIDRequest]) (*connect.Response[v1.GetUserByIDResponse], error)
+	GetUserProfile(context.Context, *connect.Request[v1.GetUserProfileRequest]) (*connect.Response[v1.GetUserProfileResponse], error)
+	BatchGetUsers(context.Context, *connect.Request[v1.BatchGetUsersRequest]) (*connect.Response[v1.BatchGetUsersResponse], error)
+	ResolveUsernames(context.Context, *connect.Request[v1.ResolveUsernamesRequest]) (*connect.Response[v1.ResolveUsernamesResponse], error)
+	AddCloseFriend(context.Context, *connect.Request[v1.AddCloseFriendRequest]) (*connect.Response[v1.AddCloseFriendResponse], error)
+	RemoveCloseFriend(context.Context, *connect.Request[v1.RemoveCloseFriendRequest]) (*connect.Response[v1.RemoveCloseFriendResponse], error)
+	ListCloseFriends(context.Context, *connect.Request[v1.ListCloseFriendsRequest]) (*connect.Response[v1.ListCloseFriendsResponse], error)
+	SetUserTags(context.Context, *connect.Request[v1.SetUserTagsRequest]) (*connect.Response[v1.SetUserTagsResponse], error)
+	GetUserTags(context.Context, *connect.Request[v1.GetUserTagsRequest]) (*connect.Response[v1.GetUserTagsResponse], error)
+	ListUsersByTag(context.Context, *connect.Request[v1.ListUsersByTagRequest]) (*connect.Response[v1.ListUsersByTagResponse], error)
 	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
 	FollowUser(context.Context, *connect.Request[v1.FollowUserRequest]) (*connect.Response[v1.FollowUserResponse], error)
 	UnfollowUser(context.Context, *connect.Request[v1.UnfollowUserRequest]) (*connect.Response[v1.UnfollowUserResponse], error)
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
 	IncrementFollowingAndFollowerCount(context.Context, *connect.Request[v1.IncrementFollowingAndFollowerCountRequest]) (*connect.Response[v1.IncrementFollowingAndFollowerCountResponse], error)
 	DecrementFollowingAndFollowerCount(context.Context, *connect.Request[v1.DecrementFollowingAndFollowerCountRequest]) (*connect.Response[v1.DecrementFollowingAndFollowerCountResponse], error)
 	FollowUserCached(context.Context, *connect.Request[v1.FollowUserCachedRequest]) (*connect.Response[v1.FollowUserCachedResponse], error)
 	UnfollowUserCached(context.Context, *connect.Request[v1.UnfollowUserCachedRequest]) (*connect.Response[v1.UnfollowUserCachedResponse], error)
 	InsertFollowerCounts(context.Context, *connect.Request[v1.InsertFollowerCountsRequest]) (*connect.Response[v1.InsertFollowerCountsResponse], error)
+	InvalidateUserCache(context.Context, *connect.Request[v1.InvalidateUserCacheRequest]) (*connect.Response[v1.InvalidateUserCacheResponse], error)
+	SuggestUsersToFollow(context.Context, *connect.Request[v1.SuggestUsersToFollowRequest]) (*connect.Response[v1.SuggestUsersToFollowResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -135,6 +183,60 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetUserByID")),
 			connect.WithClientOptions(opts...),
 		),
+		getUserProfile: connect.NewClient[v1.GetUserProfileRequest, v1.GetUserProfileResponse](
+			httpClient,
+			baseURL+UserServiceGetUserProfileProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		batchGetUsers: connect.NewClient[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse](
+			httpClient,
+			baseURL+UserServiceBatchGetUsersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BatchGetUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveUsernames: connect.NewClient[v1.ResolveUsernamesRequest, v1.ResolveUsernamesResponse](
+			httpClient,
+			baseURL+UserServiceResolveUsernamesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ResolveUsernames")),
+			connect.WithClientOptions(opts...),
+		),
+		addCloseFriend: connect.NewClient[v1.AddCloseFriendRequest, v1.AddCloseFriendResponse](
+			httpClient,
+			baseURL+UserServiceAddCloseFriendProcedure,
+			connect.WithSchema(userServiceMethods.ByName("AddCloseFriend")),
+			connect.WithClientOptions(opts...),
+		),
+		removeCloseFriend: connect.NewClient[v1.RemoveCloseFriendRequest, v1.RemoveCloseFriendResponse](
+			httpClient,
+			baseURL+UserServiceRemoveCloseFriendProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RemoveCloseFriend")),
+			connect.WithClientOptions(opts...),
+		),
+		listCloseFriends: connect.NewClient[v1.ListCloseFriendsRequest, v1.ListCloseFriendsResponse](
+			httpClient,
+			baseURL+UserServiceListCloseFriendsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListCloseFriends")),
+			connect.WithClientOptions(opts...),
+		),
+		setUserTags: connect.NewClient[v1.SetUserTagsRequest, v1.SetUserTagsResponse](
+			httpClient,
+			baseURL+UserServiceSetUserTagsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetUserTags")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserTags: connect.NewClient[v1.GetUserTagsRequest, v1.GetUserTagsResponse](
+			httpClient,
+			baseURL+UserServiceGetUserTagsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserTags")),
+			connect.WithClientOptions(opts...),
+		),
+		listUsersByTag: connect.NewClient[v1.ListUsersByTagRequest, v1.ListUsersByTagResponse](
+			httpClient,
+			baseURL+UserServiceListUsersByTagProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUsersByTag")),
+			connect.WithClientOptions(opts...),
+		),
 		listUsers: connect.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
 			httpClient,
 			baseURL+UserServiceListUsersProcedure,
@@ -153,6 +255,18 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("UnfollowUser")),
 			connect.WithClientOptions(opts...),
 		),
+		blockUser: connect.NewClient[v1.BlockUserRequest, v1.BlockUserResponse](
+			httpClient,
+			baseURL+UserServiceBlockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BlockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unblockUser: connect.NewClient[v1.UnblockUserRequest, v1.UnblockUserResponse](
+			httpClient,
+			baseURL+UserServiceUnblockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UnblockUser")),
+			connect.WithClientOptions(opts...),
+		),
 		incrementFollowingAndFollowerCount: connect.NewClient[v1.IncrementFollowingAndFollowerCountRequest, v1.IncrementFollowingAndFollowerCountResponse](
 			httpClient,
 			baseURL+UserServiceIncrementFollowingAndFollowerCountProcedure,
@@ -183,6 +297,18 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("InsertFollowerCounts")),
 			connect.WithClientOptions(opts...),
 		),
+		invalidateUserCache: connect.NewClient[v1.InvalidateUserCacheRequest, v1.InvalidateUserCacheResponse](
+			httpClient,
+			baseURL+UserServiceInvalidateUserCacheProcedure,
+			connect.WithSchema(userServiceMethods.ByName("InvalidateUserCache")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestUsersToFollow: connect.NewClient[v1.SuggestUsersToFollowRequest, v1.SuggestUsersToFollowResponse](
+			httpClient,
+			baseURL+UserServiceSuggestUsersToFollowProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SuggestUsersToFollow")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -194,14 +320,27 @@ type userServiceClient struct {
 	updateUser                         *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
 	deleteUser                         *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
 	getUserByID                        *connect.Client[v1.GetUserByIDRequest, v1.GetUserByIDResponse]
+	getUserProfile                     *connect.Client[v1.GetUserProfileRequest, v1.GetUserProfileResponse]
+	batchGetUsers                      *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
+	resolveUsernames                   *connect.Client[v1.ResolveUsernamesRequest, v1.ResolveUsernamesResponse]
+	addCloseFriend                     *connect.Client[v1.AddCloseFriendRequest, v1.AddCloseFriendResponse]
+	removeCloseFriend                  *connect.Client[v1.RemoveCloseFriendRequest, v1.RemoveCloseFriendResponse]
+	listCloseFriends                   *connect.Client[v1.ListCloseFriendsRequest, v1.ListCloseFriendsResponse]
+	setUserTags                        *connect.Client[v1.SetUserTagsRequest, v1.SetUserTagsResponse]
+	getUserTags                        *connect.Client[v1.GetUserTagsRequest, v1.GetUserTagsResponse]
+	listUsersByTag                     *connect.Client[v1.ListUsersByTagRequest, v1.ListUsersByTagResponse]
 	listUsers                          *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
 	followUser                         *connect.Client[v1.FollowUserRequest, v1.FollowUserResponse]
 	unfollowUser                       *connect.Client[v1.UnfollowUserRequest, v1.UnfollowUserResponse]
+	blockUser                          *connect.Client[v1.BlockUserRequest, v1.BlockUserResponse]
+	unblockUser                        *connect.Client[v1.UnblockUserRequest, v1.UnblockUserResponse]
 	incrementFollowingAndFollowerCount *connect.Client[v1.IncrementFollowingAndFollowerCountRequest, v1.IncrementFollowingAndFollowerCountResponse]
 	decrementFollowingAndFollowerCount *connect.Client[v1.DecrementFollowingAndFollowerCountRequest, v1.DecrementFollowingAndFollowerCountResponse]
 	followUserCached                   *connect.Client[v1.FollowUserCachedRequest, v1.FollowUserCachedResponse]
 	unfollowUserCached                 *connect.Client[v1.UnfollowUserCachedRequest, v1.UnfollowUserCachedResponse]
 	insertFollowerCounts               *connect.Client[v1.InsertFollowerCountsRequest, v1.InsertFollowerCountsResponse]
+	invalidateUserCache                *connect.Client[v1.InvalidateUserCacheRequest, v1.InvalidateUserCacheResponse]
+	suggestUsersToFollow               *connect.Client[v1.SuggestUsersToFollowRequest, v1.SuggestUsersToFollowResponse]
 }
 
 // LoginUser calls user.v1.UserService.LoginUser.
@@ -234,6 +373,51 @@ func (c *userServiceClient) GetUserByID(ctx context.Context, req *connect.Reques
 	return c.getUserByID.CallUnary(ctx, req)
 }
 
+// GetUserProfile calls user.v1.UserService.GetUserProfile.
+func (c *userServiceClient) GetUserProfile(ctx context.Context, req *connect.Request[v1.GetUserProfileRequest]) (*connect.Response[v1.GetUserProfileResponse], error) {
+	return c.getUserProfile.CallUnary(ctx, req)
+}
+
+// BatchGetUsers calls user.v1.UserService.BatchGetUsers.
+func (c *userServiceClient) BatchGetUsers(ctx context.Context, req *connect.Request[v1.BatchGetUsersRequest]) (*connect.Response[v1.BatchGetUsersResponse], error) {
+	return c.batchGetUsers.CallUnary(ctx, req)
+}
+
+// ResolveUsernames calls user.v1.UserService.ResolveUsernames.
+func (c *userServiceClient) ResolveUsernames(ctx context.Context, req *connect.Request[v1.ResolveUsernamesRequest]) (*connect.Response[v1.ResolveUsernamesResponse], error) {
+	return c.resolveUsernames.CallUnary(ctx, req)
+}
+
+// AddCloseFriend calls user.v1.UserService.AddCloseFriend.
+func (c *userServiceClient) AddCloseFriend(ctx context.Context, req *connect.Request[v1.AddCloseFriendRequest]) (*connect.Response[v1.AddCloseFriendResponse], error) {
+	return c.addCloseFriend.CallUnary(ctx, req)
+}
+
+// RemoveCloseFriend calls user.v1.UserService.RemoveCloseFriend.
+func (c *userServiceClient) RemoveCloseFriend(ctx context.Context, req *connect.Request[v1.RemoveCloseFriendRequest]) (*connect.Response[v1.RemoveCloseFriendResponse], error) {
+	return c.removeCloseFriend.CallUnary(ctx, req)
+}
+
+// ListCloseFriends calls user.v1.UserService.ListCloseFriends.
+func (c *userServiceClient) ListCloseFriends(ctx context.Context, req *connect.Request[v1.ListCloseFriendsRequest]) (*connect.Response[v1.ListCloseFriendsResponse], error) {
+	return c.listCloseFriends.CallUnary(ctx, req)
+}
+
+// SetUserTags calls user.v1.UserService.SetUserTags.
+func (c *userServiceClient) SetUserTags(ctx context.Context, req *connect.Request[v1.SetUserTagsRequest]) (*connect.Response[v1.SetUserTagsResponse], error) {
+	return c.setUserTags.CallUnary(ctx, req)
+}
+
+// GetUserTags calls user.v1.UserService.GetUserTags.
+func (c *userServiceClient) GetUserTags(ctx context.Context, req *connect.Request[v1.GetUserTagsRequest]) (*connect.Response[v1.GetUserTagsResponse], error) {
+	return c.getUserTags.CallUnary(ctx, req)
+}
+
+// ListUsersByTag calls user.v1.UserService.ListUsersByTag.
+func (c *userServiceClient) ListUsersByTag(ctx context.Context, req *connect.Request[v1.ListUsersByTagRequest]) (*connect.Response[v1.ListUsersByTagResponse], error) {
+	return c.listUsersByTag.CallUnary(ctx, req)
+}
+
 // ListUsers calls user.v1.UserService.ListUsers.
 func (c *userServiceClient) ListUsers(ctx context.Context, req *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
 	return c.listUsers.CallUnary(ctx, req)
@@ -249,6 +433,16 @@ func (c *userServiceClient) UnfollowUser(ctx context.Context, req *connect.Reque
 	return c.unfollowUser.CallUnary(ctx, req)
 }
 
+// BlockUser calls user.v1.UserService.BlockUser.
+func (c *userServiceClient) BlockUser(ctx context.Context, req *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return c.blockUser.CallUnary(ctx, req)
+}
+
+// UnblockUser calls user.v1.UserService.UnblockUser.
+func (c *userServiceClient) UnblockUser(ctx context.Context, req *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return c.unblockUser.CallUnary(ctx, req)
+}
+
 // IncrementFollowingAndFollowerCount calls user.v1.UserService.IncrementFollowingAndFollowerCount.
 func (c *userServiceClient) IncrementFollowingAndFollowerCount(ctx context.Context, req *connect.Request[v1.IncrementFollowingAndFollowerCountRequest]) (*connect.Response[v1.IncrementFollowingAndFollowerCountResponse], error) {
 	return c.incrementFollowingAndFollowerCount.CallUnary(ctx, req)
@@ -274,6 +468,16 @@ func (c *userServiceClient) InsertFollowerCounts(ctx context.Context, req *conne
 	return c.insertFollowerCounts.CallUnary(ctx, req)
 }
 
+// InvalidateUserCache calls user.v1.UserService.InvalidateUserCache.
+func (c *userServiceClient) InvalidateUserCache(ctx context.Context, req *connect.Request[v1.InvalidateUserCacheRequest]) (*connect.Response[v1.InvalidateUserCacheResponse], error) {
+	return c.invalidateUserCache.CallUnary(ctx, req)
+}
+
+// SuggestUsersToFollow calls user.v1.UserService.SuggestUsersToFollow.
+func (c *userServiceClient) SuggestUsersToFollow(ctx context.Context, req *connect.Request[v1.SuggestUsersToFollowRequest]) (*connect.Response[v1.SuggestUsersToFollowResponse], error) {
+	return c.suggestUsersToFollow.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	LoginUser(context.Context, *connect.Request[v1.LoginUserRequest]) (*connect.Response[v1.LoginUserResponse], error)
@@ -282,14 +486,27 @@ type UserServiceHandler interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	GetUserByID(context.Context, *connect.Request[v1.GetUserByIDRequest]) (*connect.Response[v1.GetUserByIDResponse], error)
+	GetUserProfile(context.Context, *connect.Request[v1.GetUserProfileRequest]) (*connect.Response[v1.GetUserProfileResponse], error)
+	BatchGetUsers(context.Context, *connect.Request[v1.BatchGetUsersRequest]) (*connect.Response[v1.BatchGetUsersResponse], error)
+	ResolveUsernames(context.Context, *connect.Request[v1.ResolveUsernamesRequest]) (*connect.Response[v1.ResolveUsernamesResponse], error)
+	AddCloseFriend(context.Context, *connect.Request[v1.AddCloseFriendRequest]) (*connect.Response[v1.AddCloseFriendResponse], error)
+	RemoveCloseFriend(context.Context, *connect.Request[v1.RemoveCloseFriendRequest]) (*connect.Response[v1.RemoveCloseFriendResponse], error)
+	ListCloseFriends(context.Context, *connect.Request[v1.ListCloseFriendsRequest]) (*connect.Response[v1.ListCloseFriendsResponse], error)
+	SetUserTags(context.Context, *connect.Request[v1.SetUserTagsRequest]) (*connect.Response[v1.SetUserTagsResponse], error)
+	GetUserTags(context.Context, *connect.Request[v1.GetUserTagsRequest]) (*connect.Response[v1.GetUserTagsResponse], error)
+	ListUsersByTag(context.Context, *connect.Request[v1.ListUsersByTagRequest]) (*connect.Response[v1.ListUsersByTagResponse], error)
 	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
 	FollowUser(context.Context, *connect.Request[v1.FollowUserRequest]) (*connect.Response[v1.FollowUserResponse], error)
 	UnfollowUser(context.Context, *connect.Request[v1.UnfollowUserRequest]) (*connect.Response[v1.UnfollowUserResponse], error)
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
 	IncrementFollowingAndFollowerCount(context.Context, *connect.Request[v1.IncrementFollowingAndFollowerCountRequest]) (*connect.Response[v1.IncrementFollowingAndFollowerCountResponse], error)
 	DecrementFollowingAndFollowerCount(context.Context, *connect.Request[v1.DecrementFollowingAndFollowerCountRequest]) (*connect.Response[v1.DecrementFollowingAndFollowerCountResponse], error)
 	FollowUserCached(context.Context, *connect.Request[v1.FollowUserCachedRequest]) (*connect.Response[v1.FollowUserCachedResponse], error)
 	UnfollowUserCached(context.Context, *connect.Request[v1.UnfollowUserCachedRequest]) (*connect.Response[v1.UnfollowUserCachedResponse], error)
 	InsertFollowerCounts(context.Context, *connect.Request[v1.InsertFollowerCountsRequest]) (*connect.Response[v1.InsertFollowerCountsResponse], error)
+	InvalidateUserCache(context.Context, *connect.Request[v1.InvalidateUserCacheRequest]) (*connect.Response[v1.InvalidateUserCacheResponse], error)
+	SuggestUsersToFollow(context.Context, *connect.Request[v1.SuggestUsersToFollowRequest]) (*connect.Response[v1.SuggestUsersToFollowResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -335,6 +552,60 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetUserByID")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetUserProfileHandler := connect.NewUnaryHandler(
+		UserServiceGetUserProfileProcedure,
+		svc.GetUserProfile,
+		connect.WithSchema(userServiceMethods.ByName("GetUserProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchGetUsersHandler := connect.NewUnaryHandler(
+		UserServiceBatchGetUsersProcedure,
+		svc.BatchGetUsers,
+		connect.WithSchema(userServiceMethods.ByName("BatchGetUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceResolveUsernamesHandler := connect.NewUnaryHandler(
+		UserServiceResolveUsernamesProcedure,
+		svc.ResolveUsernames,
+		connect.WithSchema(userServiceMethods.ByName("ResolveUsernames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceAddCloseFriendHandler := connect.NewUnaryHandler(
+		UserServiceAddCloseFriendProcedure,
+		svc.AddCloseFriend,
+		connect.WithSchema(userServiceMethods.ByName("AddCloseFriend")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRemoveCloseFriendHandler := connect.NewUnaryHandler(
+		UserServiceRemoveCloseFriendProcedure,
+		svc.RemoveCloseFriend,
+		connect.WithSchema(userServiceMethods.ByName("RemoveCloseFriend")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListCloseFriendsHandler := connect.NewUnaryHandler(
+		UserServiceListCloseFriendsProcedure,
+		svc.ListCloseFriends,
+		connect.WithSchema(userServiceMethods.ByName("ListCloseFriends")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetUserTagsHandler := connect.NewUnaryHandler(
+		UserServiceSetUserTagsProcedure,
+		svc.SetUserTags,
+		connect.WithSchema(userServiceMethods.ByName("SetUserTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserTagsHandler := connect.NewUnaryHandler(
+		UserServiceGetUserTagsProcedure,
+		svc.GetUserTags,
+		connect.WithSchema(userServiceMethods.ByName("GetUserTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUsersByTagHandler := connect.NewUnaryHandler(
+		UserServiceListUsersByTagProcedure,
+		svc.ListUsersByTag,
+		connect.WithSchema(userServiceMethods.ByName("ListUsersByTag")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUsersHandler := connect.NewUnaryHandler(
 		UserServiceListUsersProcedure,
 		svc.ListUsers,
@@ -353,6 +624,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("UnfollowUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceBlockUserHandler := connect.NewUnaryHandler(
+		UserServiceBlockUserProcedure,
+		svc.BlockUser,
+		connect.WithSchema(userServiceMethods.ByName("BlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUnblockUserHandler := connect.NewUnaryHandler(
+		UserServiceUnblockUserProcedure,
+		svc.UnblockUser,
+		connect.WithSchema(userServiceMethods.ByName("UnblockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceIncrementFollowingAndFollowerCountHandler := connect.NewUnaryHandler(
 		UserServiceIncrementFollowingAndFollowerCountProcedure,
 		svc.IncrementFollowingAndFollowerCount,
@@ -383,6 +666,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("InsertFollowerCounts")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceInvalidateUserCacheHandler := connect.NewUnaryHandler(
+		UserServiceInvalidateUserCacheProcedure,
+		svc.InvalidateUserCache,
+		connect.WithSchema(userServiceMethods.ByName("InvalidateUserCache")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSuggestUsersToFollowHandler := connect.NewUnaryHandler(
+		UserServiceSuggestUsersToFollowProcedure,
+		svc.SuggestUsersToFollow,
+		connect.WithSchema(userServiceMethods.ByName("SuggestUsersToFollow")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceLoginUserProcedure:
@@ -397,12 +692,34 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
 		case UserServiceGetUserByIDProcedure:
 			userServiceGetUserByIDHandler.ServeHTTP(w, r)
+		case UserServiceGetUserProfileProcedure:
+			userServiceGetUserProfileHandler.ServeHTTP(w, r)
+		case UserServiceBatchGetUsersProcedure:
+			userServiceBatchGetUsersHandler.ServeHTTP(w, r)
+		case UserServiceResolveUsernamesProcedure:
+			userServiceResolveUsernamesHandler.ServeHTTP(w, r)
+		case UserServiceAddCloseFriendProcedure:
+			userServiceAddCloseFriendHandler.ServeHTTP(w, r)
+		case UserServiceRemoveCloseFriendProcedure:
+			userServiceRemoveCloseFriendHandler.ServeHTTP(w, r)
+		case UserServiceListCloseFriendsProcedure:
+			userServiceListCloseFriendsHandler.ServeHTTP(w, r)
+		case UserServiceSetUserTagsProcedure:
+			userServiceSetUserTagsHandler.ServeHTTP(w, r)
+		case UserServiceGetUserTagsProcedure:
+			userServiceGetUserTagsHandler.ServeHTTP(w, r)
+		case UserServiceListUsersByTagProcedure:
+			userServiceListUsersByTagHandler.ServeHTTP(w, r)
 		case UserServiceListUsersProcedure:
 			userServiceListUsersHandler.ServeHTTP(w, r)
 		case UserServiceFollowUserProcedure:
 			userServiceFollowUserHandler.ServeHTTP(w, r)
 		case UserServiceUnfollowUserProcedure:
 			userServiceUnfollowUserHandler.ServeHTTP(w, r)
+		case UserServiceBlockUserProcedure:
+			userServiceBlockUserHandler.ServeHTTP(w, r)
+		case UserServiceUnblockUserProcedure:
+			userServiceUnblockUserHandler.ServeHTTP(w, r)
 		case UserServiceIncrementFollowingAndFollowerCountProcedure:
 			userServiceIncrementFollowingAndFollowerCountHandler.ServeHTTP(w, r)
 		case UserServiceDecrementFollowingAndFollowerCountProcedure:
@@ -413,6 +730,10 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUnfollowUserCachedHandler.ServeHTTP(w, r)
 		case UserServiceInsertFollowerCountsProcedure:
 			userServiceInsertFollowerCountsHandler.ServeHTTP(w, r)
+		case UserServiceInvalidateUserCacheProcedure:
+			userServiceInvalidateUserCacheHandler.ServeHTTP(w, r)
+		case UserServiceSuggestUsersToFollowProcedure:
+			userServiceSuggestUsersToFollowHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -446,6 +767,42 @@ func (UnimplementedUserServiceHandler) GetUserByID(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetUserByID is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) GetUserProfile(context.Context, *connect.Request[v1.GetUserProfileRequest]) (*connect.Response[v1.GetUserProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetUserProfile is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchGetUsers(context.Context, *connect.Request[v1.BatchGetUsersRequest]) (*connect.Response[v1.BatchGetUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.BatchGetUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ResolveUsernames(context.Context, *connect.Request[v1.ResolveUsernamesRequest]) (*connect.Response[v1.ResolveUsernamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ResolveUsernames is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AddCloseFriend(context.Context, *connect.Request[v1.AddCloseFriendRequest]) (*connect.Response[v1.AddCloseFriendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.AddCloseFriend is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RemoveCloseFriend(context.Context, *connect.Request[v1.RemoveCloseFriendRequest]) (*connect.Response[v1.RemoveCloseFriendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.RemoveCloseFriend is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListCloseFriends(context.Context, *connect.Request[v1.ListCloseFriendsRequest]) (*connect.Response[v1.ListCloseFriendsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListCloseFriends is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetUserTags(context.Context, *connect.Request[v1.SetUserTagsRequest]) (*connect.Response[v1.SetUserTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.SetUserTags is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserTags(context.Context, *connect.Request[v1.GetUserTagsRequest]) (*connect.Response[v1.GetUserTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetUserTags is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUsersByTag(context.Context, *connect.Request[v1.ListUsersByTagRequest]) (*connect.Response[v1.ListUsersByTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListUsersByTag is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.ListUsers is not implemented"))
 }
@@ -458,6 +815,14 @@ func (UnimplementedUserServiceHandler) UnfollowUser(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.UnfollowUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.BlockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.UnblockUser is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) IncrementFollowingAndFollowerCount(context.Context, *connect.Request[v1.IncrementFollowingAndFollowerCountRequest]) (*connect.Response[v1.IncrementFollowingAndFollowerCountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.IncrementFollowingAndFollowerCount is not implemented"))
 }
@@ -477,3 +842,11 @@ func (UnimplementedUserServiceHandler) UnfollowUserCached(context.Context, *conn
 func (UnimplementedUserServiceHandler) InsertFollowerCounts(context.Context, *connect.Request[v1.InsertFollowerCountsRequest]) (*connect.Response[v1.InsertFollowerCountsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.InsertFollowerCounts is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) InvalidateUserCache(context.Context, *connect.Request[v1.InvalidateUserCacheRequest]) (*connect.Response[v1.InvalidateUserCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.InvalidateUserCache is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SuggestUsersToFollow(context.Context, *connect.Request[v1.SuggestUsersToFollowRequest]) (*connect.Response[v1.SuggestUsersToFollowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.SuggestUsersToFollow is not implemented"))
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/reconcile"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/database"
+	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
+	"github.com/yaninyzwitty/threads-go-backend/shared/pkg"
+)
+
+// main runs a one-off repair or backfill of post-service derived data, e.g.
+//
+//	go run ./services/post-service/cmd/reconcile -job=liked-posts -dry-run
+func main() {
+	job := flag.String("job", "", "job to run: liked-posts")
+	dryRun := flag.Bool("dry-run", false, "report what would change without writing")
+	pageSize := flag.Int("page-size", 500, "rows per page")
+	rate := flag.Int("rate", 10, "max pages per second (0 = unlimited)")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		slog.Warn("No .env file found")
+	}
+
+	cfg := pkg.Config{}
+	if err := cfg.LoadConfig("config.yaml"); err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	sessionCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	dbSession, err := database.NewAstraDB().Connect(sessionCtx, &database.AstraConfig{
+		Username: cfg.Database.Username,
+		Path:     cfg.Database.Path,
+		Token:    helpers.GetEnvOrDefault("ASTRA_DB_TOKEN", ""),
+	}, 10*time.Second)
+	if err != nil {
+		slog.Error("failed to connect to astra db", "error", err)
+		os.Exit(1)
+	}
+	defer dbSession.Close()
+
+	postRepo := repository.NewPostRepository(dbSession, nil)
+	opts := reconcile.Options{
+		DryRun:   *dryRun,
+		PageSize: *pageSize,
+		Rate:     *rate,
+	}
+
+	switch *job {
+	case "liked-posts":
+		report, err := reconcile.LikedPosts(ctx, postRepo, opts)
+		if err != nil {
+			slog.Error("liked posts backfill failed", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("liked posts backfilled",
+			"rows_scanned", report.RowsScanned,
+			"copied", report.Copied,
+			"dry_run", *dryRun)
+	default:
+		slog.Error("unknown job", "job", *job)
+		flag.Usage()
+		os.Exit(2)
+	}
+}
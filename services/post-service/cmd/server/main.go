@@ -12,10 +12,14 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
 	"github.com/yaninyzwitty/threads-go-backend/gen/posts/v1/postsv1connect"
+	"github.com/yaninyzwitty/threads-go-backend/gen/user/v1/userv1connect"
 	"github.com/yaninyzwitty/threads-go-backend/services/post-service/controller"
 	"github.com/yaninyzwitty/threads-go-backend/services/post-service/kafka"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/media"
 	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/search"
 	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
 	"github.com/yaninyzwitty/threads-go-backend/shared/database"
 	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
@@ -45,13 +49,19 @@ func main() {
 		os.Exit(1)
 	}
 
-	// rdbOpts, err := redis.ParseURL(helpers.GetEnvOrDefault("REDIS_URL", ""))
-	// if err != nil {
-	// 	slog.Error("invalid REDIS_URL", "error", err)
-	// 	os.Exit(1)
-	// }
-	// rdb := redis.NewClient(rdbOpts)
-	// refreshTokenStore := auth.RefreshTokenStore{Redis: rdb}
+	// Redis is optional here: it only backs caches and the trending hashtags list.
+	var rdb *redis.Client
+	if redisURL := helpers.GetEnvOrDefault("REDIS_URL", ""); redisURL != "" {
+		rdbOpts, err := redis.ParseURL(redisURL)
+		if err != nil {
+			slog.Error("invalid REDIS_URL", "error", err)
+			os.Exit(1)
+		}
+		rdb = redis.NewClient(rdbOpts)
+		defer rdb.Close()
+	} else {
+		slog.Warn("REDIS_URL not set; timeline caching and trending hashtags are disabled")
+	}
 
 	db := database.NewAstraDB()
 	sessionCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
@@ -71,14 +81,72 @@ func main() {
 	kafkaReader := queue.NewKafkaReader(queue.Config{
 		Brokers:  cfg.Queue.Brokers,
 		Topic:    cfg.Queue.Topic,
-		GroupID:  cfg.Queue.GroupID,
+		GroupID:  cfg.PostServer.GroupID,
 		Username: cfg.Queue.Username,
 		Password: helpers.GetEnvOrDefault("KAFKA_PASSWORD", ""),
 	})
 	defer kafkaReader.Close()
 
-	postRepo := repository.NewPostRepository(dbSession)
-	postController := controller.NewPostController(postRepo)
+	// user-service client used to hydrate post authors
+	userServiceClient := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		helpers.GetEnvOrDefault("USER_SERVICE_URL", fmt.Sprintf("http://localhost:%d", cfg.UserServer.Port)),
+	)
+
+	var searchIndex search.Index
+	switch cfg.PostServer.SearchBackend {
+	case "", "embedded":
+		searchIndex = search.NewMemoryIndex()
+	default:
+		slog.Error("unknown search backend", "backend", cfg.PostServer.SearchBackend)
+		os.Exit(1)
+	}
+
+	var blobs media.BlobStore
+	switch cfg.PostServer.Media.Backend {
+	case "", "filesystem":
+		blobs, err = media.NewFileStore(cfg.PostServer.Media.Dir)
+		if err != nil {
+			slog.Error("failed to open media store", "error", err)
+			os.Exit(1)
+		}
+	default:
+		slog.Error("unknown media backend", "backend", cfg.PostServer.Media.Backend)
+		os.Exit(1)
+	}
+	mediaOpts := media.Options{
+		PublicURL:      cfg.PostServer.Media.PublicURL,
+		MaxUploadBytes: cfg.PostServer.Media.MaxUploadBytes,
+		MaxDimension:   cfg.PostServer.Media.MaxDimension,
+		UploadTTL:      cfg.PostServer.Media.UploadTTL,
+	}
+	// A zero upload_ttl would write reservations that never expire.
+	if mediaOpts.MaxUploadBytes <= 0 {
+		mediaOpts.MaxUploadBytes = media.DefaultMaxUploadBytes
+	}
+	if mediaOpts.MaxDimension <= 0 {
+		mediaOpts.MaxDimension = media.DefaultMaxDimension
+	}
+	if mediaOpts.UploadTTL <= 0 {
+		mediaOpts.UploadTTL = media.DefaultUploadTTL
+	}
+
+	postRepo := repository.NewPostRepository(dbSession, rdb)
+	postController := controller.NewPostController(postRepo, userServiceClient, cfg.PostServer.CelebrityFollowerThreshold, cfg.PostServer.EditWindow, searchIndex, mediaOpts)
+
+	// The embedded index keeps nothing across restarts; rebuild it in the background.
+	// Searches return partial results until it finishes.
+	if _, ok := searchIndex.(*search.MemoryIndex); ok {
+		go func() {
+			indexed, err := postController.BackfillSearchIndex(ctx)
+			if err != nil {
+				slog.Error("search index backfill failed", "indexed", indexed, "error", err)
+				return
+			}
+			slog.Info("search index backfilled", "indexed", indexed)
+		}()
+	}
+	mediaHandler := media.NewHandler(postRepo, blobs, mediaOpts)
 
 	postPath, postHandler := postsv1connect.NewPostServiceHandler(
 		postController,
@@ -87,6 +155,8 @@ func main() {
 
 	mux := http.NewServeMux()
 	mux.Handle(postPath, postHandler)
+	mux.HandleFunc("PUT "+media.UploadPath+"{id}", mediaHandler.Upload)
+	mux.HandleFunc("GET "+media.FilesPath+"{key}", mediaHandler.Serve)
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.PostServer.Port),
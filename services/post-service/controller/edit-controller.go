@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ---------------- Editing ------------------
+
+// EditPost lets the author change a post's content within the edit window. The replaced
+// version is kept in the post's revision history; engagement counts are untouched.
+func (c *PostController) EditPost(
+	ctx context.Context,
+	req *connect.Request[postsv1.EditPostRequest],
+) (*connect.Response[postsv1.EditPostResponse], error) {
+	if req.Msg.GetPostId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	post, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if post.User.GetId() != user.Id {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("permission denied"))
+	}
+
+	if time.Since(post.CreatedAt.AsTime()) > c.editWindow {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("edit window has closed"))
+	}
+
+	if post.Content == req.Msg.GetContent() && post.ImageUrl == req.Msg.GetImageUrl() {
+		c.hydrateUsers(ctx, req.Header(), post)
+		return connect.NewResponse(&postsv1.EditPostResponse{Post: post}), nil
+	}
+
+	edited := proto.Clone(post).(*postsv1.Post)
+	edited.Content = req.Msg.GetContent()
+	edited.ImageUrl = req.Msg.GetImageUrl()
+	// Attachments are kept, so only a post without them needs text or an image.
+	if edited.Content == "" && edited.ImageUrl == "" && len(edited.Media) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("content or image_url is required"))
+	}
+	parseContent(edited)
+	// Mentions added by an edit are linked but not notified.
+	if err := c.resolveMentions(ctx, req.Header(), edited); err != nil {
+		return nil, err
+	}
+	// Cassandra keeps milliseconds; truncate so the response matches what is stored.
+	edited.EditedAt = timestamppb.New(time.Now().Truncate(time.Millisecond))
+
+	if err := c.postsRepo.EditPost(ctx, edited, post); err != nil {
+		if errors.Is(err, repository.ErrEditConflict) {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to edit post: %w", err))
+	}
+
+	c.hydrateUsers(ctx, req.Header(), edited)
+
+	return connect.NewResponse(&postsv1.EditPostResponse{
+		Post: edited,
+	}), nil
+}
+
+// ListPostRevisions returns the earlier versions of a post to anyone who can see it.
+func (c *PostController) ListPostRevisions(
+	ctx context.Context,
+	req *connect.Request[postsv1.ListPostRevisionsRequest],
+) (*connect.Response[postsv1.ListPostRevisionsResponse], error) {
+	if req.Msg.GetPostId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxRevisionPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxRevisionPageSize))
+	}
+
+	post, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	visible, err := c.filterVisible(ctx, viewerID(ctx), []*postsv1.Post{post})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(visible) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
+	}
+
+	revisions, nextPage, err := c.postsRepo.ListPostRevisions(ctx, post.Id, req.Msg.GetPageSize(), req.Msg.GetPagingState())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&postsv1.ListPostRevisionsResponse{
+		Revisions:   revisions,
+		PagingState: nextPage,
+	}), nil
+}
+
+// UpdatePostIndexes applies a post.edited event to the denormalized copies of the post.
+// It is called by the kafka consumer and is not part of PostService.
+func (c *PostController) UpdatePostIndexes(ctx context.Context, post *postsv1.Post) error {
+	if post.GetId() == 0 || post.User.GetId() == 0 || post.EditedAt == nil {
+		return errors.New("edited post is required")
+	}
+	return c.postsRepo.UpdatePostIndexes(ctx, post)
+}
+
+// syncEditedIndexes copies the post's latest edit into index rows just written from its
+// creation event. UpdatePostIndexes skips rows that do not exist yet, so an edit
+// processed before the post was indexed is applied here instead.
+func (c *PostController) syncEditedIndexes(ctx context.Context, postId int64) error {
+	post, err := c.postsRepo.GetPost(ctx, postId)
+	if err != nil {
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return nil
+		}
+		return err
+	}
+	if post.EditedAt == nil {
+		return nil
+	}
+	return c.postsRepo.UpdatePostIndexes(ctx, post)
+}
@@ -0,0 +1,337 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/ranking"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	maxFeedPageSize         = 50
+	feedFollowingCandidates = 200 // newest posts taken from the viewer's home timeline
+	feedSecondDegreeAuthors = 20  // top follow suggestions whose posts are candidates
+	feedPostsPerAuthor      = 5   // recent posts taken per suggested author
+	feedTrendingTags        = 5   // top trending hashtags whose posts are candidates
+	feedPostsPerTag         = 10  // newest posts taken per trending hashtag
+	feedTrendingBuckets     = 24  // hour buckets read per trending hashtag
+	feedAffinityLikes       = 200 // recent likes used to measure author affinity
+	feedAffinityTTL         = 5 * time.Minute
+	feedSessionTTL          = 30 * time.Minute
+)
+
+// ---------------- Recommended Feed ------------------
+
+// GetRecommendedFeed ranks candidate posts from followed accounts, the viewer's
+// second-degree network and trending hashtags with the controller's scorer. Each call returns the best posts
+// not yet served in the session, and what was served is logged for offline evaluation.
+func (c *PostController) GetRecommendedFeed(
+	ctx context.Context,
+	req *connect.Request[postsv1.GetRecommendedFeedRequest],
+) (*connect.Response[postsv1.GetRecommendedFeedResponse], error) {
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxFeedPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxFeedPageSize))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	sessionId := req.Msg.GetSessionId()
+	if sessionId == "" {
+		sessionId = newFeedSessionID()
+	}
+
+	sources, err := c.feedCandidates(ctx, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to gather feed candidates: %w", err))
+	}
+
+	seen := c.postsRepo.GetSeenPostIDs(ctx, user.Id, sessionId)
+	ids := make([]int64, 0, len(sources))
+	for id := range sources {
+		if !seen[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get feed candidates: %w", err))
+	}
+
+	posts := make([]*postsv1.Post, 0, len(found))
+	for _, post := range found {
+		if post.User.GetId() == user.Id || post.ReplyToPostId != 0 {
+			continue
+		}
+		posts = append(posts, post)
+	}
+
+	posts, err = c.filterVisible(ctx, user.Id, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	candidates, err := c.feedFeatures(ctx, user.Id, posts, sources)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load ranking features: %w", err))
+	}
+
+	now := time.Now()
+	type scored struct {
+		candidate ranking.Candidate
+		score     float64
+	}
+	ranked := make([]scored, len(candidates))
+	for i, candidate := range candidates {
+		ranked[i] = scored{candidate: candidate, score: c.feedScorer.Score(candidate, now)}
+	}
+	slices.SortFunc(ranked, func(a, b scored) int {
+		if a.score != b.score {
+			if a.score > b.score {
+				return -1
+			}
+			return 1
+		}
+		return int(b.candidate.Post.Id - a.candidate.Post.Id) // newer first on ties
+	})
+	ranked = ranked[:min(len(ranked), int(req.Msg.GetPageSize()))]
+
+	posts = make([]*postsv1.Post, len(ranked))
+	for i, r := range ranked {
+		posts[i] = r.candidate.Post
+	}
+
+	// Reposts whose original is gone or hidden drop out here, before the page is logged.
+	posts, err = c.embedPosts(ctx, user.Id, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	kept := make(map[int64]bool, len(posts))
+	for _, post := range posts {
+		kept[post.Id] = true
+	}
+
+	served := make([]int64, 0, len(posts))
+	impressions := make([]repository.FeedImpression, 0, len(posts))
+	for _, r := range ranked {
+		if !kept[r.candidate.Post.Id] {
+			continue
+		}
+		impressions = append(impressions, repository.FeedImpression{
+			PostID:   r.candidate.Post.Id,
+			Position: len(served),
+			Source:   string(r.candidate.Source),
+			Score:    r.score,
+		})
+		served = append(served, r.candidate.Post.Id)
+	}
+
+	states, err := c.viewerStates(ctx, user.Id, posts...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), posts...)
+
+	c.postsRepo.AddSeenPostIDs(ctx, user.Id, sessionId, served, feedSessionTTL)
+	if err := c.postsRepo.LogFeedImpressions(ctx, user.Id, sessionId, c.feedScorer.Name, impressions); err != nil {
+		slog.Warn("failed to log feed impressions", "viewer_id", user.Id, "error", err)
+	}
+
+	return connect.NewResponse(&postsv1.GetRecommendedFeedResponse{
+		Posts:        posts,
+		SessionId:    sessionId,
+		ViewerStates: states,
+	}), nil
+}
+
+// feedCandidates returns candidate post ids with the source each came from. A post
+// found by several sources keeps the first: following, then second degree, then trending.
+func (c *PostController) feedCandidates(ctx context.Context, viewerId int64) (map[int64]ranking.Source, error) {
+	var following, secondDegree, trending []int64
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		fannedOut, err := c.postsRepo.ListHomeTimeline(gctx, viewerId, 0, feedFollowingCandidates)
+		if err != nil {
+			return err
+		}
+		merged, err := c.mergedTimelinePosts(gctx, viewerId, 0, feedFollowingCandidates)
+		if err != nil {
+			return err
+		}
+		following = mergeNewestFirst(feedFollowingCandidates, append(merged, fannedOut)...)
+		return nil
+	})
+
+	g.Go(func() error {
+		authors, err := c.postsRepo.ListSuggestedUserIDs(gctx, viewerId, feedSecondDegreeAuthors)
+		if err != nil {
+			return err
+		}
+
+		lists := make([][]int64, len(authors))
+
+		ag, actx := errgroup.WithContext(gctx)
+		ag.SetLimit(postReadLimit)
+		for i, authorId := range authors {
+			ag.Go(func() error {
+				ids, err := c.recentPostIDs(actx, authorId)
+				if err != nil {
+					return err
+				}
+				lists[i] = ids[:min(len(ids), feedPostsPerAuthor)]
+				return nil
+			})
+		}
+		if err := ag.Wait(); err != nil {
+			return err
+		}
+
+		secondDegree = slices.Concat(lists...)
+		return nil
+	})
+
+	g.Go(func() error {
+		tags, err := c.postsRepo.ListTrendingHashtags(gctx, feedTrendingTags)
+		if err != nil {
+			return err
+		}
+
+		lists := make([][]int64, len(tags))
+
+		tg, tctx := errgroup.WithContext(gctx)
+		tg.SetLimit(postReadLimit)
+		for i, tag := range tags {
+			tg.Go(func() error {
+				ids, _, _, err := c.postsRepo.ListHashtagPostIDs(tctx, tag.Tag, repository.HashtagCursor{}, feedPostsPerTag, feedTrendingBuckets)
+				if err != nil {
+					return err
+				}
+				lists[i] = ids
+				return nil
+			})
+		}
+		if err := tg.Wait(); err != nil {
+			return err
+		}
+
+		trending = slices.Concat(lists...)
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sources := make(map[int64]ranking.Source, len(following)+len(secondDegree)+len(trending))
+	for _, id := range following {
+		sources[id] = ranking.SourceFollowing
+	}
+	for _, id := range secondDegree {
+		if _, ok := sources[id]; !ok {
+			sources[id] = ranking.SourceSecondDegree
+		}
+	}
+	for _, id := range trending {
+		if _, ok := sources[id]; !ok {
+			sources[id] = ranking.SourceTrending
+		}
+	}
+	return sources, nil
+}
+
+// feedFeatures attaches engagement counters and the viewer's affinity for each author.
+func (c *PostController) feedFeatures(ctx context.Context, viewerId int64, posts []*postsv1.Post, sources map[int64]ranking.Source) ([]ranking.Candidate, error) {
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.Id
+	}
+
+	var (
+		engagements map[int64]*postsv1.PostEngagements
+		authorLikes map[int64]int
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		engagements, err = c.postsRepo.SelectEngagementCountsByIDs(gctx, ids)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		authorLikes, err = c.authorAffinity(gctx, viewerId)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	candidates := make([]ranking.Candidate, len(posts))
+	for i, post := range posts {
+		candidates[i] = ranking.Candidate{
+			Post:        post,
+			Source:      sources[post.Id],
+			Engagements: engagements[post.Id],
+			AuthorLikes: authorLikes[post.User.GetId()],
+		}
+	}
+	return candidates, nil
+}
+
+// authorAffinity counts the viewer's recent likes per author. The liked authors are
+// cached briefly per viewer, so paging through a feed session reads them once.
+func (c *PostController) authorAffinity(ctx context.Context, viewerId int64) (map[int64]int, error) {
+	authors, ok := c.postsRepo.GetCachedLikedAuthors(ctx, viewerId)
+	if !ok {
+		likes, _, err := c.postsRepo.ListLikedPostsByUser(ctx, viewerId, feedAffinityLikes, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]int64, len(likes))
+		for i, like := range likes {
+			ids[i] = like.PostId
+		}
+
+		liked, err := c.postsRepo.GetPostsByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		authors = make([]int64, 0, len(liked))
+		for _, post := range liked {
+			authors = append(authors, post.User.GetId())
+		}
+		c.postsRepo.CacheLikedAuthors(ctx, viewerId, authors, feedAffinityTTL)
+	}
+
+	counts := make(map[int64]int)
+	for _, authorId := range authors {
+		counts[authorId]++
+	}
+	return counts, nil
+}
+
+func newFeedSessionID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/entities"
+	"github.com/yaninyzwitty/threads-go-backend/shared/tags"
+)
+
+const (
+	maxHashtagPageSize    = 100
+	maxHashtagBucketsRead = 72 // non-empty hour buckets read per ListPostsByHashtag call
+	maxTrendingLimit      = 50
+)
+
+// parseContent fills in the hashtags and entities of a post about to be written.
+func parseContent(post *postsv1.Post) {
+	parsed := entities.Parse(post.Content)
+
+	post.Hashtags = entities.Values(parsed, entities.Hashtag)
+	post.Entities = entities.ToProto(parsed)
+}
+
+// ---------------- Hashtags ------------------
+
+// ListPostsByHashtag returns public posts tagged with a hashtag, newest first.
+func (c *PostController) ListPostsByHashtag(
+	ctx context.Context,
+	req *connect.Request[postsv1.ListPostsByHashtagRequest],
+) (*connect.Response[postsv1.ListPostsByHashtagResponse], error) {
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxHashtagPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxHashtagPageSize))
+	}
+
+	tag, err := tags.Normalize(req.Msg.GetTag())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cursor, err := decodeHashtagCursor(req.Msg.GetCursor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	postIds, next, more, err := c.postsRepo.ListHashtagPostIDs(ctx, tag, cursor, int(req.Msg.GetPageSize()), maxHashtagBucketsRead)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, postIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get tagged posts: %w", err))
+	}
+
+	// Entries can outlive the tag: deleted posts are missing, and an edit that dropped
+	// the tag may not have been applied to the index yet.
+	posts := make([]*postsv1.Post, 0, len(found))
+	for _, id := range postIds {
+		if post, ok := found[id]; ok && slices.Contains(post.Hashtags, tag) {
+			posts = append(posts, post)
+		}
+	}
+
+	viewer := viewerID(ctx)
+
+	posts, err = c.filterVisible(ctx, viewer, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	posts, err = c.embedPosts(ctx, viewer, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	states, err := c.viewerStates(ctx, viewer, posts...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), posts...)
+
+	var nextCursor []byte
+	if more {
+		nextCursor = encodeHashtagCursor(next)
+	}
+
+	return connect.NewResponse(&postsv1.ListPostsByHashtagResponse{
+		Posts:        posts,
+		Cursor:       nextCursor,
+		ViewerStates: states,
+	}), nil
+}
+
+// GetTrending returns the hashtags whose activity is furthest above their usual level.
+// Only public posts count towards trends.
+func (c *PostController) GetTrending(
+	ctx context.Context,
+	req *connect.Request[postsv1.GetTrendingRequest],
+) (*connect.Response[postsv1.GetTrendingResponse], error) {
+	if req.Msg.GetLimit() <= 0 || req.Msg.GetLimit() > maxTrendingLimit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be between 1 and %d", maxTrendingLimit))
+	}
+
+	hashtags, err := c.postsRepo.ListTrendingHashtags(ctx, int(req.Msg.GetLimit()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&postsv1.GetTrendingResponse{
+		Hashtags: hashtags,
+	}), nil
+}
+
+// IndexPostHashtags applies a post.created or post.edited event to posts_by_hashtag.
+// For edits, tags the edit dropped are found from the revision it stored. Rows are
+// written at the version's time, so an older event applied late can't bring back a tag
+// a newer edit removed. Close friends posts are never indexed. It returns the number of
+// tags the post was written under, and is called by the kafka consumer, not part of
+// PostService.
+func (c *PostController) IndexPostHashtags(ctx context.Context, post *postsv1.Post) (int, error) {
+	if post.GetId() == 0 || post.User.GetId() == 0 || post.CreatedAt == nil {
+		return 0, errors.New("post with id, user and created_at is required")
+	}
+
+	if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		return 0, nil
+	}
+
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, post.Id)
+	if err != nil || deleted {
+		return 0, err
+	}
+
+	version := post.CreatedAt.AsTime()
+	if post.EditedAt != nil {
+		version = post.EditedAt.AsTime()
+	}
+
+	if post.EditedAt != nil {
+		previous, err := c.postsRepo.GetRevisionContent(ctx, post.Id, post.EditedAt.AsTime())
+		if err != nil {
+			return 0, err
+		}
+
+		var dropped []string
+		for _, tag := range entities.Values(entities.Parse(previous), entities.Hashtag) {
+			if !slices.Contains(post.Hashtags, tag) {
+				dropped = append(dropped, tag)
+			}
+		}
+		if err := c.postsRepo.RemovePostHashtags(ctx, post, dropped, version); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := c.postsRepo.AddPostHashtags(ctx, post, post.Hashtags, version); err != nil {
+		return 0, err
+	}
+
+	// Same race as FanOutPost: a purge running alongside may have missed these rows.
+	deleted, err = c.postsRepo.IsPostDeleted(ctx, post.Id)
+	if err != nil {
+		return 0, err
+	}
+	if deleted {
+		return 0, c.postsRepo.RemovePostHashtags(ctx, post, post.Hashtags, time.Now())
+	}
+
+	return len(post.Hashtags), nil
+}
+
+// Hashtag cursors are the bucket followed by the post id, both 8-byte big-endian.
+func encodeHashtagCursor(cursor repository.HashtagCursor) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], uint64(cursor.Bucket))
+	binary.BigEndian.PutUint64(b[8:], uint64(cursor.BeforeID))
+	return b
+}
+
+func decodeHashtagCursor(b []byte) (repository.HashtagCursor, error) {
+	if len(b) == 0 {
+		return repository.HashtagCursor{}, nil
+	}
+	if len(b) != 16 {
+		return repository.HashtagCursor{}, errors.New("invalid cursor")
+	}
+	return repository.HashtagCursor{
+		Bucket:   int64(binary.BigEndian.Uint64(b[:8])),
+		BeforeID: int64(binary.BigEndian.Uint64(b[8:])),
+	}, nil
+}
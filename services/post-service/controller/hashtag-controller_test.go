@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+)
+
+func TestParseContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hashtags []string
+		entities []userv1.TextEntity_Type
+	}{
+		{"no entities", "just text", nil, nil},
+		{"deduplicated tags", "#Go and #go and #GO", []string{"go"}, []userv1.TextEntity_Type{
+			userv1.TextEntity_TYPE_HASHTAG, userv1.TextEntity_TYPE_HASHTAG, userv1.TextEntity_TYPE_HASHTAG,
+		}},
+		{"mixed", "@ann #rust https://example.com", []string{"rust"}, []userv1.TextEntity_Type{
+			userv1.TextEntity_TYPE_MENTION, userv1.TextEntity_TYPE_HASHTAG, userv1.TextEntity_TYPE_URL,
+		}},
+		{"tags past the cap stay plain text", strings.Repeat("#tag ", 3) + "#a #b #c #d #e #f #g #h #i #j #k #tag", []string{
+			"tag", "a", "b", "c", "d", "e", "f", "g", "h", "i",
+		}, slices.Repeat([]userv1.TextEntity_Type{userv1.TextEntity_TYPE_HASHTAG}, 13)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := &postsv1.Post{Content: tt.content, Hashtags: []string{"stale"}}
+			parseContent(post)
+
+			if !slices.Equal(post.Hashtags, tt.hashtags) {
+				t.Errorf("hashtags = %v, want %v", post.Hashtags, tt.hashtags)
+			}
+			var kinds []userv1.TextEntity_Type
+			for _, e := range post.Entities {
+				kinds = append(kinds, e.GetType())
+			}
+			if !slices.Equal(kinds, tt.entities) {
+				t.Errorf("entity types = %v, want %v", kinds, tt.entities)
+			}
+		})
+	}
+}
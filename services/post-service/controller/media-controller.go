@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/media"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
+	"github.com/yaninyzwitty/threads-go-backend/shared/snowflake"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxMediaPerPost  = 4
+	maxAltTextLength = 1000 // characters
+)
+
+// ---------------- Media ------------------
+
+// CreateUpload reserves a media id for the caller and returns where to upload the file.
+// The reservation lapses after the upload window unless the file arrives first.
+func (c *PostController) CreateUpload(
+	ctx context.Context,
+	req *connect.Request[postsv1.CreateUploadRequest],
+) (*connect.Response[postsv1.CreateUploadResponse], error) {
+	if !media.Supported(req.Msg.GetContentType()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported content type %q", req.Msg.GetContentType()))
+	}
+	if req.Msg.GetSizeBytes() <= 0 || req.Msg.GetSizeBytes() > c.mediaOpts.MaxUploadBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("size must be between 1 and %d bytes", c.mediaOpts.MaxUploadBytes))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	mediaId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate snowflake id: %w", err))
+	}
+
+	token, tokenHash, err := media.NewUploadToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	now := time.Now()
+	if err := c.postsRepo.CreateMediaUpload(ctx, &repository.MediaUpload{
+		ID:          int64(mediaId),
+		OwnerID:     user.Id,
+		ContentType: req.Msg.GetContentType(),
+		MaxBytes:    req.Msg.GetSizeBytes(),
+		TokenHash:   tokenHash,
+		CreatedAt:   now,
+	}, c.mediaOpts.UploadTTL); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&postsv1.CreateUploadResponse{
+		MediaId:     int64(mediaId),
+		UploadUrl:   c.mediaOpts.UploadURL(int64(mediaId)),
+		UploadToken: token,
+		ExpiresAt:   timestamppb.New(now.Add(c.mediaOpts.UploadTTL)),
+	}), nil
+}
+
+// postMedia checks a new post's attachments and returns them as stored on the post. Each
+// must be a finished upload of the author's that no other post uses; attachMedia claims
+// them once the post is ready to be written.
+func (c *PostController) postMedia(ctx context.Context, authorId int64, attachments []*postsv1.MediaAttachment) ([]*postsv1.Media, error) {
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+	if len(attachments) > maxMediaPerPost {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d media per post", maxMediaPerPost))
+	}
+
+	ids := make([]int64, 0, len(attachments))
+	for _, attachment := range attachments {
+		if slices.Contains(ids, attachment.GetMediaId()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("media %d is attached twice", attachment.GetMediaId()))
+		}
+		if utf8.RuneCountInString(attachment.GetAltText()) > maxAltTextLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("alt text is longer than %d characters", maxAltTextLength))
+		}
+		ids = append(ids, attachment.GetMediaId())
+	}
+
+	uploads, err := c.postsRepo.GetMediaUploads(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	attached := make([]*postsv1.Media, len(attachments))
+	for i, attachment := range attachments {
+		upload, ok := uploads[attachment.GetMediaId()]
+		if !ok || upload.OwnerID != authorId {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("media %d not found", attachment.GetMediaId()))
+		}
+		if upload.Status == repository.MediaAttached {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("media %d is already attached to a post", upload.ID))
+		}
+		if upload.Status != repository.MediaReady {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("media %d has not been uploaded", upload.ID))
+		}
+
+		attached[i] = &postsv1.Media{
+			Id:          upload.ID,
+			Type:        postsv1.MediaType_MEDIA_TYPE_IMAGE,
+			ContentType: upload.ContentType,
+			Url:         upload.URL,
+			Width:       upload.Width,
+			Height:      upload.Height,
+			AltText:     attachment.GetAltText(),
+		}
+	}
+	return attached, nil
+}
+
+// attachMedia marks a new post's uploads as used, so they can't go on another post. If
+// one was taken in the meantime, the ones already claimed are released.
+func (c *PostController) attachMedia(ctx context.Context, attached []*postsv1.Media) error {
+	for i, m := range attached {
+		ok, err := c.postsRepo.AttachMediaUpload(ctx, m.Id)
+		if err == nil && !ok {
+			err = connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("media %d is already attached to a post", m.Id))
+		}
+		if err != nil {
+			c.releaseMedia(ctx, attached[:i])
+			if connect.CodeOf(err) == connect.CodeUnknown {
+				err = connect.NewError(connect.CodeInternal, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseMedia frees uploads claimed for a post that was not written. Failures are
+// logged; the uploads just stay unusable.
+func (c *PostController) releaseMedia(ctx context.Context, attached []*postsv1.Media) {
+	for _, m := range attached {
+		if err := c.postsRepo.ReleaseMediaUpload(ctx, m.Id); err != nil {
+			slog.Error("failed to release media upload", "media_id", m.Id, "error", err)
+		}
+	}
+}
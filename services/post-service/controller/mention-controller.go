@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+)
+
+const (
+	maxMentionsPerPost  = 20
+	mentionResolveBatch = 50 // usernames per ResolveUsernames call, the user-service's limit
+)
+
+// resolveMentions looks up the handles mentioned in a post's entities through the
+// user-service. Resolved mentions get their UserId and are collected in
+// MentionedUserIds; unknown handles, and users past the first maxMentionsPerPost, are
+// dropped from the entities and stay plain text.
+func (c *PostController) resolveMentions(ctx context.Context, header http.Header, post *postsv1.Post) error {
+	var handles []string
+	for _, entity := range post.Entities {
+		if entity.Type != userv1.TextEntity_TYPE_MENTION {
+			continue
+		}
+		if handle := strings.ToLower(entity.Value); !slices.Contains(handles, handle) {
+			handles = append(handles, handle)
+		}
+	}
+
+	post.MentionedUserIds = nil
+	if len(handles) == 0 {
+		return nil
+	}
+
+	// Handles are in order of appearance, so once a batch brings the resolved count to
+	// the cap every later handle would be dropped anyway.
+	userIds := make(map[string]int64, len(handles))
+	for batch := range slices.Chunk(handles, mentionResolveBatch) {
+		if len(userIds) >= maxMentionsPerPost {
+			break
+		}
+
+		req := connect.NewRequest(&userv1.ResolveUsernamesRequest{Usernames: batch})
+		req.Header().Set("Authorization", header.Get("Authorization"))
+
+		res, err := c.userClient.ResolveUsernames(ctx, req)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve mentions: %w", err))
+		}
+		maps.Copy(userIds, res.Msg.UserIds)
+	}
+
+	post.Entities, post.MentionedUserIds = linkMentions(post.Entities, userIds)
+	return nil
+}
+
+// linkMentions sets UserId on the mention entities whose handle is in userIds and
+// returns the entities to keep along with the distinct mentioned users. Mentions of
+// unknown handles, and of new users once maxMentionsPerPost are linked, are dropped.
+// entities is filtered in place.
+func linkMentions(entities []*userv1.TextEntity, userIds map[string]int64) ([]*userv1.TextEntity, []int64) {
+	var mentioned []int64
+	kept := entities[:0]
+	for _, entity := range entities {
+		if entity.Type == userv1.TextEntity_TYPE_MENTION {
+			id, ok := userIds[strings.ToLower(entity.Value)]
+			if !ok {
+				continue
+			}
+			if !slices.Contains(mentioned, id) {
+				if len(mentioned) == maxMentionsPerPost {
+					continue
+				}
+				mentioned = append(mentioned, id)
+			}
+			entity.UserId = id
+		}
+		kept = append(kept, entity)
+	}
+	return kept, mentioned
+}
+
+// mentionRecipients returns the mentioned users who should hear about a new post: not
+// the author, not users who have blocked the author, and for close friends posts only
+// the author's close friends.
+func (c *PostController) mentionRecipients(ctx context.Context, post *postsv1.Post) ([]int64, error) {
+	authorId := post.User.GetId()
+
+	candidates := make([]int64, 0, len(post.MentionedUserIds))
+	for _, id := range post.MentionedUserIds {
+		if id != authorId {
+			candidates = append(candidates, id)
+		}
+	}
+
+	blockers, err := c.postsRepo.BlockedAuthorBy(ctx, authorId, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipients []int64
+	for _, id := range candidates {
+		if blockers[id] {
+			continue
+		}
+		if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+			ok, err := c.postsRepo.IsCloseFriend(ctx, authorId, id)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		recipients = append(recipients, id)
+	}
+	return recipients, nil
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+)
+
+func TestLinkMentions(t *testing.T) {
+	mention := func(handle string) *userv1.TextEntity {
+		return &userv1.TextEntity{Type: userv1.TextEntity_TYPE_MENTION, Value: handle}
+	}
+	tag := &userv1.TextEntity{Type: userv1.TextEntity_TYPE_HASHTAG, Value: "go"}
+
+	many := make(map[string]int64)
+	var crowd []*userv1.TextEntity
+	for i := range maxMentionsPerPost + 5 {
+		handle := fmt.Sprintf("user%d", i)
+		many[handle] = int64(i + 1)
+		crowd = append(crowd, mention(handle))
+	}
+	crowd = append(crowd, mention("user0"))
+
+	tests := []struct {
+		name      string
+		entities  []*userv1.TextEntity
+		userIds   map[string]int64
+		kept      int
+		mentioned []int64
+	}{
+		{"unknown handles dropped", []*userv1.TextEntity{mention("ann"), tag, mention("ghost")}, map[string]int64{"ann": 7}, 2, []int64{7}},
+		{"case-insensitive and deduplicated", []*userv1.TextEntity{mention("Ann"), mention("ann")}, map[string]int64{"ann": 7}, 2, []int64{7}},
+		{"unknown handles do not count toward the cap", append([]*userv1.TextEntity{mention("ghost")}, crowd[:maxMentionsPerPost]...), many, maxMentionsPerPost, crowdIDs(maxMentionsPerPost)},
+		{"users past the cap dropped, repeats kept", crowd, many, maxMentionsPerPost + 1, crowdIDs(maxMentionsPerPost)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept, mentioned := linkMentions(slices.Clone(tt.entities), tt.userIds)
+			if len(kept) != tt.kept {
+				t.Errorf("kept %d entities, want %d", len(kept), tt.kept)
+			}
+			if !slices.Equal(mentioned, tt.mentioned) {
+				t.Errorf("mentioned = %v, want %v", mentioned, tt.mentioned)
+			}
+			for _, e := range kept {
+				if e.Type == userv1.TextEntity_TYPE_MENTION && !slices.Contains(mentioned, e.UserId) {
+					t.Errorf("kept mention %q links user %d, not in %v", e.Value, e.UserId, mentioned)
+				}
+			}
+		})
+	}
+}
+
+// crowdIDs returns the ids 1..n that TestLinkMentions gives user0..user(n-1).
+func crowdIDs(n int) []int64 {
+	ids := make([]int64, n)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	return ids
+}
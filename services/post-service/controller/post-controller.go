@@ -4,29 +4,288 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
 
 	"connectrpc.com/connect"
 	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
 	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/gen/user/v1/userv1connect"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/media"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/ranking"
 	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/search"
 	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
 	"github.com/yaninyzwitty/threads-go-backend/shared/snowflake"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	maxThreadDepth      = 50 // ancestors walked by GetThread before the chain is cut off
+	maxReplyPageSize    = 100
+	threadScanBatch     = 500   // replies_by_root rows read per query
+	maxThreadScan       = 10000 // replies_by_root rows read per GetThread call
+	postReadLimit       = 10    // concurrent post reads per request
+	maxLikePageSize     = 100
+	maxRevisionPageSize = 100
+)
+
 type PostController struct {
-	postsRepo *repository.PostRepository
+	postsRepo  *repository.PostRepository
+	userClient userv1connect.UserServiceClient
+
+	// Authors with at least this many followers skip fan-out; 0 disables the check.
+	celebrityThreshold int64
+
+	// feedScorer ranks GetRecommendedFeed candidates.
+	feedScorer ranking.Scorer
+
+	// How long after creation the author may edit a post; 0 disables editing.
+	editWindow time.Duration
+
+	// searchIndex answers SearchPosts and is kept up to date from post events.
+	searchIndex search.Index
+
+	// mediaOpts limit uploads and build their URLs.
+	mediaOpts media.Options
 }
 
-func NewPostController(postsRepo *repository.PostRepository) *PostController {
+func NewPostController(postsRepo *repository.PostRepository, userClient userv1connect.UserServiceClient, celebrityThreshold int64, editWindow time.Duration, searchIndex search.Index, mediaOpts media.Options) *PostController {
 	return &PostController{
-		postsRepo: postsRepo,
+		postsRepo:          postsRepo,
+		userClient:         userClient,
+		celebrityThreshold: celebrityThreshold,
+		editWindow:         editWindow,
+		searchIndex:        searchIndex,
+		mediaOpts:          mediaOpts,
+		feedScorer:         ranking.Default,
+	}
+}
+
+// viewerID returns the authenticated caller's id, or 0 for anonymous callers.
+func viewerID(ctx context.Context) int64 {
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return 0
+	}
+	return user.Id
+}
+
+// filterVisible drops the posts the viewer is not allowed to see. Close friends posts
+// are kept for their author and for users on the author's list; the list is checked
+// once per author.
+func (c *PostController) filterVisible(ctx context.Context, viewerId int64, posts []*postsv1.Post) ([]*postsv1.Post, error) {
+	allowed := make(map[int64]bool)
+
+	visible := posts[:0]
+	for _, post := range posts {
+		if post.GetAudience() != postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+			visible = append(visible, post)
+			continue
+		}
+
+		authorId := post.User.GetId()
+		ok, checked := allowed[authorId]
+		if !checked {
+			switch {
+			case viewerId == 0:
+				ok = false
+			case viewerId == authorId:
+				ok = true
+			default:
+				var err error
+				ok, err = c.postsRepo.IsCloseFriend(ctx, authorId, viewerId)
+				if err != nil {
+					return nil, fmt.Errorf("failed to check post audience: %w", err)
+				}
+			}
+			allowed[authorId] = ok
+		}
+
+		if ok {
+			visible = append(visible, post)
+		}
+	}
+
+	return visible, nil
+}
+
+// viewerStates returns the viewer's state for each post and embedded post, keyed by
+// post id. Anonymous viewers get an empty state for every post.
+func (c *PostController) viewerStates(ctx context.Context, viewerId int64, posts ...*postsv1.Post) (map[int64]*postsv1.ViewerState, error) {
+	states, ids := emptyViewerStates(posts)
+	if viewerId == 0 || len(ids) == 0 {
+		return states, nil
+	}
+
+	var liked, reposted map[int64]bool
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		liked, err = c.postsRepo.LikedPostIDs(gctx, viewerId, ids)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		reposted, err = c.postsRepo.RepostedPostIDs(gctx, viewerId, ids)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to load viewer state: %w", err)
+	}
+
+	for id, state := range states {
+		state.Liked = liked[id]
+		state.Reposted = reposted[id]
+	}
+	return states, nil
+}
+
+// emptyViewerStates returns a blank state for each post and embedded post, and their
+// ids without repeats.
+func emptyViewerStates(posts []*postsv1.Post) (map[int64]*postsv1.ViewerState, []int64) {
+	states := make(map[int64]*postsv1.ViewerState, len(posts))
+	var ids []int64
+	for _, post := range posts {
+		for _, p := range []*postsv1.Post{post, post.GetEmbeddedPost()} {
+			if p == nil || states[p.Id] != nil {
+				continue
+			}
+			states[p.Id] = &postsv1.ViewerState{}
+			ids = append(ids, p.Id)
+		}
+	}
+	return states, ids
+}
+
+// hydrateUsers replaces the id-only Post.User on each post with the author's public fields.
+// The caller's Authorization header is forwarded to the user-service. Hydration is best
+// effort: on failure the posts keep their bare user ids.
+func (c *PostController) hydrateUsers(ctx context.Context, header http.Header, posts ...*postsv1.Post) {
+	var ids []int64
+	for _, post := range posts {
+		if post != nil && post.User.GetId() != 0 {
+			ids = append(ids, post.User.GetId())
+		}
+		if embedded := post.GetEmbeddedPost(); embedded.GetUser().GetId() != 0 {
+			ids = append(ids, embedded.User.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	users, err := c.publicUsers(ctx, header, ids)
+	if err != nil {
+		slog.Warn("failed to hydrate post authors", "error", err)
+		return
+	}
+
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		if user, ok := users[post.User.GetId()]; ok {
+			post.User = user
+		}
+		if embedded := post.EmbeddedPost; embedded != nil {
+			if user, ok := users[embedded.User.GetId()]; ok {
+				embedded.User = user
+			}
+		}
+	}
+}
+
+// publicUsers fetches users from the user-service and keeps only their public fields.
+func (c *PostController) publicUsers(ctx context.Context, header http.Header, ids []int64) (map[int64]*userv1.User, error) {
+	req := connect.NewRequest(&userv1.BatchGetUsersRequest{Ids: ids})
+	req.Header().Set("Authorization", header.Get("Authorization"))
+
+	res, err := c.userClient.BatchGetUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[int64]*userv1.User, len(res.Msg.Users))
+	for _, user := range res.Msg.Users {
+		users[user.Id] = &userv1.User{
+			Id:            user.Id,
+			Username:      user.Username,
+			FullName:      user.FullName,
+			ProfilePicUrl: user.ProfilePicUrl,
+			IsVerified:    user.IsVerified,
+		}
 	}
+	return users, nil
+}
+
+// embedPosts sets EmbeddedPost on quotes and reposts to the referenced post when the
+// viewer can see it. Reposts whose original is gone or hidden are dropped; quotes are
+// kept without the embed.
+func (c *PostController) embedPosts(ctx context.Context, viewerId int64, posts []*postsv1.Post) ([]*postsv1.Post, error) {
+	var ids []int64
+	seen := make(map[int64]bool)
+	for _, post := range posts {
+		for _, id := range []int64{post.QuotePostId, post.RepostOfPostId} {
+			if id != 0 && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return posts, nil
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedded posts: %w", err)
+	}
+
+	originals := make([]*postsv1.Post, 0, len(found))
+	for _, original := range found {
+		originals = append(originals, original)
+	}
+
+	originals, err = c.filterVisible(ctx, viewerId, originals)
+	if err != nil {
+		return nil, err
+	}
+	return attachEmbeds(posts, originals), nil
+}
+
+// attachEmbeds sets EmbeddedPost from originals, the referenced posts the viewer can
+// see. Reposts of anything else are dropped; quotes are kept without the embed. posts
+// is filtered in place.
+func attachEmbeds(posts, originals []*postsv1.Post) []*postsv1.Post {
+	byID := make(map[int64]*postsv1.Post, len(originals))
+	for _, original := range originals {
+		byID[original.Id] = original
+	}
+
+	kept := posts[:0]
+	for _, post := range posts {
+		switch {
+		case post.RepostOfPostId != 0:
+			original, ok := byID[post.RepostOfPostId]
+			if !ok {
+				continue
+			}
+			post.EmbeddedPost = original
+		case post.QuotePostId != 0:
+			post.EmbeddedPost = byID[post.QuotePostId]
+		}
+		kept = append(kept, post)
+	}
+	return kept
 }
 
 func (c *PostController) CreatePost(ctx context.Context, req *connect.Request[postsv1.CreatePostRequest]) (*connect.Response[postsv1.CreatePostResponse], error) {
-	if req.Msg.Content == "" || req.Msg.ImageUrl == "" || req.Msg.UserId == 0 {
+	if req.Msg.UserId == 0 || (req.Msg.Content == "" && req.Msg.ImageUrl == "" && len(req.Msg.Media) == 0) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
 	}
 
@@ -40,6 +299,63 @@ func (c *PostController) CreatePost(ctx context.Context, req *connect.Request[po
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("unauthenticated"))
 	}
 
+	audience := req.Msg.GetAudience()
+	switch audience {
+	case postsv1.Audience_AUDIENCE_UNSPECIFIED:
+		audience = postsv1.Audience_AUDIENCE_PUBLIC
+	case postsv1.Audience_AUDIENCE_PUBLIC, postsv1.Audience_AUDIENCE_CLOSE_FRIENDS:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid audience"))
+	}
+
+	var replyTo, rootId int64
+	if parentId := req.Msg.GetReplyToPostId(); parentId != 0 {
+		parent, err := c.postsRepo.GetPost(ctx, parentId)
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("parent post not found"))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get parent post: %w", err))
+		}
+
+		visible, err := c.filterVisible(ctx, user.Id, []*postsv1.Post{parent})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if len(visible) == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("parent post not found"))
+		}
+
+		replyTo = parent.Id
+		rootId = parent.RootPostId
+		if rootId == 0 {
+			rootId = parent.Id
+		}
+	}
+
+	if quoteId := req.Msg.GetQuotePostId(); quoteId != 0 {
+		quoted, err := c.postsRepo.GetPost(ctx, quoteId)
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("quoted post not found"))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get quoted post: %w", err))
+		}
+
+		visible, err := c.filterVisible(ctx, user.Id, []*postsv1.Post{quoted})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if len(visible) == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("quoted post not found"))
+		}
+	}
+
+	attached, err := c.postMedia(ctx, user.Id, req.Msg.GetMedia())
+	if err != nil {
+		return nil, err
+	}
+
 	postId, err := snowflake.GenerateID()
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate snowflake id: %w", err))
@@ -52,10 +368,32 @@ func (c *PostController) CreatePost(ctx context.Context, req *connect.Request[po
 		User: &userv1.User{
 			Id: req.Msg.UserId,
 		},
-		CreatedAt: timestamppb.Now(),
+		CreatedAt:     timestamppb.Now(),
+		Audience:      audience,
+		ReplyToPostId: replyTo,
+		RootPostId:    rootId,
+		QuotePostId:   req.Msg.GetQuotePostId(),
+		Media:         attached,
+	}
+	parseContent(post)
+	if err := c.resolveMentions(ctx, req.Header(), post); err != nil {
+		return nil, err
+	}
+
+	notify, err := c.mentionRecipients(ctx, post)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := c.attachMedia(ctx, attached); err != nil {
+		return nil, err
 	}
 
-	if err := c.postsRepo.CreatePost(ctx, post); err != nil {
+	if err := c.postsRepo.CreatePost(ctx, post, notify); err != nil {
+		// A timed out batch may still have written the post, which keeps its media.
+		if !repository.IsBatchWriteTimeout(err) {
+			c.releaseMedia(ctx, attached)
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create post: %w", err))
 	}
 
@@ -74,13 +412,28 @@ func (c *PostController) GetPost(
 	}
 
 	post, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	visible, err := c.filterVisible(ctx, viewerID(ctx), []*postsv1.Post{post})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if post == nil {
+	if len(visible) == 0 {
+		// Same answer as a missing post so restricted posts don't leak their existence.
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
 	}
 
+	if _, err := c.embedPosts(ctx, viewerID(ctx), visible); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), post)
+
 	return connect.NewResponse(&postsv1.GetPostResponse{
 		Post: post,
 	}), nil
@@ -104,6 +457,25 @@ func (c *PostController) ListPostsByUser(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	viewer := viewerID(ctx)
+
+	response.Posts, err = c.filterVisible(ctx, viewer, response.Posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response.Posts, err = c.embedPosts(ctx, viewer, response.Posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response.ViewerStates, err = c.viewerStates(ctx, viewer, response.Posts...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), response.Posts...)
+
 	return connect.NewResponse(response), nil
 }
 
@@ -122,20 +494,20 @@ func (c *PostController) DeletePost(
 	}
 
 	post, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if post == nil {
-		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
-	}
 
 	// Authorization: only the creator can delete
 	if post.User.Id != user.Id {
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("permission denied"))
 	}
 
-	// Delete the post
-	if err := c.postsRepo.DeletePost(ctx, req.Msg.GetPostId()); err != nil {
+	// Delete the post; the post.deleted consumers remove its copies
+	if err := c.postsRepo.DeletePost(ctx, post); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -152,6 +524,15 @@ func (c *PostController) InitializePostEngagements(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post id is required"))
 	}
 
+	// Counters can't be reliably recreated once deleted, so never touch a deleted post's.
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, req.Msg.PostId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted {
+		return connect.NewResponse(&postsv1.InitializePostEngagementsResponse{}), nil
+	}
+
 	if err := c.postsRepo.InsertEngagementsCount(ctx, req.Msg.PostId); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to initialize post engagements"))
 	}
@@ -174,6 +555,10 @@ func (c *PostController) CreatePostIndexedByUser(
 		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to index post by user"))
 	}
 
+	if err := c.syncEditedIndexes(ctx, req.Msg.Post.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	return connect.NewResponse(&postsv1.CreatePostIndexedByUserResponse{
 		Success: true,
 	}), nil
@@ -234,18 +619,72 @@ func (c *PostController) CreateLike(ctx context.Context, req *connect.Request[po
 
 	}
 
+	if _, err := c.postsRepo.GetPost(ctx, req.Msg.PostId); err != nil {
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	like := &postsv1.Like{
 		PostId:    req.Msg.PostId,
 		UserId:    user.Id,
 		CreatedAt: timestamppb.Now(),
 	}
-	if err := c.postsRepo.CreateLike(ctx, like); err != nil {
+	like, created, err := c.postsRepo.CreateLike(ctx, like)
+	if err != nil {
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create like: %w", err))
 	}
 	return connect.NewResponse(&postsv1.CreateLikeResponse{
-		Like: like,
+		Like:    like,
+		Created: created,
+	}), nil
+
+}
+
+func (c *PostController) DeleteLike(ctx context.Context, req *connect.Request[postsv1.DeleteLikeRequest]) (*connect.Response[postsv1.DeleteLikeResponse], error) {
+	if req.Msg.PostId == 0 || req.Msg.UserId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid fields"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthorized"))
+	}
+
+	if req.Msg.UserId != user.Id {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("ids dont match"))
+	}
+
+	deleted, err := c.postsRepo.DeleteLike(ctx, req.Msg.PostId, user.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete like: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.DeleteLikeResponse{
+		Deleted: deleted,
 	}), nil
+}
+
+func (c *PostController) DeleteLikeByUser(
+	ctx context.Context,
+	req *connect.Request[postsv1.DeleteLikeByUserRequest],
+) (*connect.Response[postsv1.DeleteLikeByUserResponse], error) {
+	if req.Msg.Like == nil || req.Msg.GetEventId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("like and event_id are required"))
+	}
 
+	deleted, err := c.postsRepo.DeleteUserLike(ctx, req.Msg.GetLike(), req.Msg.GetEventId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete user like: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.DeleteLikeByUserResponse{
+		Deleted: deleted,
+	}), nil
 }
 
 func (c *PostController) CreateLikeByUser(
@@ -257,6 +696,15 @@ func (c *PostController) CreateLikeByUser(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("like is required"))
 	}
 
+	// The post may have been deleted, and its likes purged, since the like was made.
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, req.Msg.GetLike().GetPostId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted {
+		return connect.NewResponse(&postsv1.CreateLikeByUserResponse{}), nil
+	}
+
 	if err := c.postsRepo.CreateUserLike(ctx, req.Msg.GetLike()); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create user like: %w", err))
 	}
@@ -274,15 +722,86 @@ func (c *PostController) IncrementPostLikes(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
 	}
 
-	if err := c.postsRepo.SafeIncrementEngagementCounts(ctx, req.Msg.GetPostId(), "like_count"); err != nil {
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, req.Msg.GetPostId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted {
+		return connect.NewResponse(&postsv1.IncrementPostLikesResponse{}), nil
+	}
+
+	if req.Msg.GetEventId() == "" {
+		if err := c.postsRepo.SafeIncrementEngagementCounts(ctx, req.Msg.GetPostId(), "like_count"); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment like count: %w", err))
+		}
+
+		return connect.NewResponse(&postsv1.IncrementPostLikesResponse{
+			Incremented: true,
+		}), nil
+	}
+
+	incremented, err := c.postsRepo.IncrementEngagementCountOnce(ctx, req.Msg.GetPostId(), "like_count", req.Msg.GetEventId())
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment like count: %w", err))
 	}
 
 	return connect.NewResponse(&postsv1.IncrementPostLikesResponse{
-		Incremented: true,
+		Incremented: incremented,
+	}), nil
+}
+
+func (c *PostController) IncrementUserPostCount(
+	ctx context.Context,
+	req *connect.Request[postsv1.IncrementUserPostCountRequest],
+) (*connect.Response[postsv1.IncrementUserPostCountResponse], error) {
+	if req.Msg.GetUserId() == 0 || req.Msg.GetEventId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id and event_id are required"))
+	}
+
+	incremented, err := c.postsRepo.IncrementUserPostCountOnce(ctx, req.Msg.GetUserId(), req.Msg.GetEventId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment post count: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.IncrementUserPostCountResponse{
+		Incremented: incremented,
+	}), nil
+}
+
+func (c *PostController) DecrementUserPostCount(
+	ctx context.Context,
+	req *connect.Request[postsv1.DecrementUserPostCountRequest],
+) (*connect.Response[postsv1.DecrementUserPostCountResponse], error) {
+	if req.Msg.GetUserId() == 0 || req.Msg.GetEventId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id and event_id are required"))
+	}
+
+	decremented, err := c.postsRepo.DecrementUserPostCountOnce(ctx, req.Msg.GetUserId(), req.Msg.GetEventId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decrement post count: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.DecrementUserPostCountResponse{
+		Decremented: decremented,
 	}), nil
 }
 
+// DeletePostCopies applies a post.deleted event to the post's denormalized rows. It is
+// called by the kafka consumer and is not part of PostService.
+func (c *PostController) DeletePostCopies(ctx context.Context, post *postsv1.Post) error {
+	if post.GetId() == 0 || post.User.GetId() == 0 {
+		return errors.New("post with id and user is required")
+	}
+
+	if post.ReplyToPostId != 0 {
+		if _, err := c.postsRepo.RemoveReplyFromParent(ctx, post); err != nil {
+			return err
+		}
+	}
+
+	return c.postsRepo.DeletePostCopies(ctx, post)
+}
+
 func (c *PostController) GetPostWithMetadata(ctx context.Context, req *connect.Request[postsv1.GetPostRequest]) (*connect.Response[postsv1.GetPostWithMetadataResponse], error) {
 	postID := req.Msg.GetPostId()
 	if postID == 0 {
@@ -295,12 +814,12 @@ func (c *PostController) GetPostWithMetadata(ctx context.Context, req *connect.R
 	)
 
 	// Create an errgroup with context
-	g, ctx := errgroup.WithContext(ctx)
+	g, gctx := errgroup.WithContext(ctx)
 
 	// Fetch post concurrently
 	g.Go(func() error {
 		var err error
-		post, err = c.postsRepo.GetPost(ctx, postID)
+		post, err = c.postsRepo.GetPost(gctx, postID)
 		if err != nil {
 			return connect.NewError(connect.CodeNotFound, fmt.Errorf("post not found: %w", err))
 		}
@@ -311,7 +830,7 @@ func (c *PostController) GetPostWithMetadata(ctx context.Context, req *connect.R
 	// Fetch engagements concurrently
 	g.Go(func() error {
 		var err error
-		postEngagements, err = c.postsRepo.SelectEngagementCounts(ctx, postID)
+		postEngagements, err = c.postsRepo.SelectEngagementCounts(gctx, postID)
 		if err != nil {
 			return connect.NewError(connect.CodeNotFound, fmt.Errorf("post engagements not found: %w", err))
 		}
@@ -323,6 +842,27 @@ func (c *PostController) GetPostWithMetadata(ctx context.Context, req *connect.R
 		return nil, err
 	}
 
+	visible, err := c.filterVisible(ctx, viewerID(ctx), []*postsv1.Post{post})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(visible) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
+	}
+
+	viewer := viewerID(ctx)
+
+	if _, err := c.embedPosts(ctx, viewer, visible); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	states, err := c.viewerStates(ctx, viewer, post)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), post)
+
 	// Build and return the response
 	resp := &postsv1.GetPostWithMetadataResponse{
 		Post:         post,
@@ -330,7 +870,578 @@ func (c *PostController) GetPostWithMetadata(ctx context.Context, req *connect.R
 		ShareCount:   postEngagements.ShareCount,
 		CommentCount: postEngagements.CommentCount,
 		RepostCount:  postEngagements.RepostCount,
+		ViewerState:  states[post.Id],
 	}
 
 	return connect.NewResponse(resp), nil
 }
+
+// ---------------- Threads ------------------
+
+// GetThread returns a post with the chain of posts it replies to and the first page of
+// its direct replies. The chain stops at the first ancestor the viewer can't see or
+// that no longer exists.
+func (c *PostController) GetThread(
+	ctx context.Context,
+	req *connect.Request[postsv1.GetThreadRequest],
+) (*connect.Response[postsv1.GetThreadResponse], error) {
+	postID := req.Msg.GetPostId()
+	if postID == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > maxReplyPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxReplyPageSize))
+	}
+	afterId, err := decodeTimelineCursor(req.Msg.GetPagingState())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	viewer := viewerID(ctx)
+
+	post, err := c.postsRepo.GetPost(ctx, postID)
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	visible, err := c.filterVisible(ctx, viewer, []*postsv1.Post{post})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(visible) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
+	}
+
+	var (
+		ancestors []*postsv1.Post
+		replies   []*postsv1.Post
+		nextPage  []byte
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		var err error
+		ancestors, err = c.threadAncestors(gctx, viewer, post)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		replies, nextPage, err = c.threadDescendants(gctx, viewer, post, int(pageSize), afterId)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load thread: %w", err))
+	}
+
+	all := append(append([]*postsv1.Post{post}, ancestors...), replies...)
+	if _, err := c.embedPosts(ctx, viewer, all); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	states, err := c.viewerStates(ctx, viewer, all...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.hydrateUsers(ctx, req.Header(), all...)
+
+	return connect.NewResponse(&postsv1.GetThreadResponse{
+		Ancestors:    ancestors,
+		Post:         post,
+		Replies:      replies,
+		PagingState:  nextPage,
+		ViewerStates: states,
+	}), nil
+}
+
+// threadDescendants returns the next page of replies below post, at any depth, in the
+// order they were posted after afterId. The conversation's replies_by_root partition is
+// read from the post onwards and a reply kept when its parent is post or a reply already
+// kept. For a reply inside a conversation that means the rows before the cursor are read
+// again on every page, so its descendants are only looked for among the first
+// maxThreadScan replies posted after it.
+func (c *PostController) threadDescendants(ctx context.Context, viewer int64, post *postsv1.Post, pageSize int, afterId int64) ([]*postsv1.Post, []byte, error) {
+	rootId, scanFrom := post.RootPostId, post.Id
+	if rootId == 0 {
+		// Every reply in the conversation descends from its root.
+		rootId, scanFrom = post.Id, max(post.Id, afterId)
+	}
+
+	page := newThreadPage(post, pageSize, afterId)
+
+scan:
+	for scanned := 0; scanned < maxThreadScan; {
+		rows, err := c.postsRepo.ListThreadReplies(ctx, rootId, scanFrom, threadScanBatch)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			scanFrom = row.ID
+			if page.add(row) {
+				break scan
+			}
+		}
+		if len(rows) < threadScanBatch {
+			break
+		}
+		scanned += len(rows)
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, page.ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	replies := make([]*postsv1.Post, 0, len(page.ids))
+	for _, id := range page.ids {
+		// Deleted replies are gone from posts but keep their place in the tree.
+		if reply, ok := found[id]; ok {
+			replies = append(replies, reply)
+		}
+	}
+
+	replies, err = c.filterVisible(ctx, viewer, replies)
+	if err != nil {
+		return nil, nil, err
+	}
+	return replies, page.nextPage, nil
+}
+
+// threadPage collects one page of a post's descendants from replies_by_root rows read in
+// posting order.
+type threadPage struct {
+	wholeConversation bool // the post is the root, so every row descends from it
+	afterId           int64
+	size              int
+
+	subtree  map[int64]bool
+	ids      []int64
+	nextPage []byte
+}
+
+func newThreadPage(post *postsv1.Post, size int, afterId int64) *threadPage {
+	return &threadPage{
+		wholeConversation: post.RootPostId == 0,
+		afterId:           afterId,
+		size:              size,
+		subtree:           map[int64]bool{post.Id: true},
+	}
+}
+
+// add takes the next row and reports whether the page is full. A descendant posted
+// before the cursor still joins the subtree, so its own replies are found.
+func (p *threadPage) add(row repository.ThreadReply) bool {
+	if !p.wholeConversation && !p.subtree[row.ParentID] {
+		return false
+	}
+	p.subtree[row.ID] = true
+	if row.ID <= p.afterId {
+		return false
+	}
+
+	p.ids = append(p.ids, row.ID)
+	if len(p.ids) < p.size {
+		return false
+	}
+	p.nextPage = encodeTimelineCursor(row.ID)
+	return true
+}
+
+// threadAncestors walks reply_to_post_id up from post and returns the visible part of
+// the chain, root first.
+func (c *PostController) threadAncestors(ctx context.Context, viewer int64, post *postsv1.Post) ([]*postsv1.Post, error) {
+	var chain []*postsv1.Post // parent first
+	for parentID := post.ReplyToPostId; parentID != 0 && len(chain) < maxThreadDepth; {
+		parent, err := c.postsRepo.GetPost(ctx, parentID)
+		if errors.Is(err, repository.ErrPostNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, parent)
+		parentID = parent.ReplyToPostId
+	}
+
+	// filterVisible compacts its argument in place, so hand it a copy.
+	visible, err := c.filterVisible(ctx, viewer, append([]*postsv1.Post(nil), chain...))
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[int64]bool, len(visible))
+	for _, p := range visible {
+		allowed[p.Id] = true
+	}
+
+	ancestors := make([]*postsv1.Post, 0, len(chain))
+	for _, p := range chain {
+		if !allowed[p.Id] {
+			break
+		}
+		ancestors = append(ancestors, p)
+	}
+	slices.Reverse(ancestors)
+
+	return ancestors, nil
+}
+
+func (c *PostController) ListReplies(
+	ctx context.Context,
+	req *connect.Request[postsv1.ListRepliesRequest],
+) (*connect.Response[postsv1.ListRepliesResponse], error) {
+	if req.Msg.GetPostId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxReplyPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxReplyPageSize))
+	}
+
+	viewer := viewerID(ctx)
+
+	// Listing replies must not reveal a parent the viewer can't open.
+	parent, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	visible, err := c.filterVisible(ctx, viewer, []*postsv1.Post{parent})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(visible) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
+	}
+
+	replies, nextPage, err := c.postsRepo.ListReplies(ctx, req.Msg.GetPostId(), req.Msg.GetPageSize(), req.Msg.GetPagingState())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	replies, err = c.filterVisible(ctx, viewer, replies)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	replies, err = c.embedPosts(ctx, viewer, replies)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	states, err := c.viewerStates(ctx, viewer, replies...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), replies...)
+
+	return connect.NewResponse(&postsv1.ListRepliesResponse{
+		Posts:        replies,
+		PagingState:  nextPage,
+		ViewerStates: states,
+	}), nil
+}
+
+func (c *PostController) CreateReplyIndexedByPost(
+	ctx context.Context,
+	req *connect.Request[postsv1.CreateReplyIndexedByPostRequest],
+) (*connect.Response[postsv1.CreateReplyIndexedByPostResponse], error) {
+	reply := req.Msg.GetReply()
+	if reply == nil || reply.Id == 0 || reply.ReplyToPostId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reply with reply_to_post_id is required"))
+	}
+
+	// A deleted reply or parent must not be indexed (or counted) again.
+	for _, id := range []int64{reply.Id, reply.ReplyToPostId} {
+		deleted, err := c.postsRepo.IsPostDeleted(ctx, id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if deleted {
+			return connect.NewResponse(&postsv1.CreateReplyIndexedByPostResponse{}), nil
+		}
+	}
+
+	// Link the reply into its conversation first: the insert is idempotent, while a
+	// redelivered event stops at the replies_by_post marker below.
+	if err := c.postsRepo.IndexThreadReply(ctx, reply); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	indexed, err := c.postsRepo.CreateReplyIndexedByPost(ctx, reply)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to index reply: %w", err))
+	}
+
+	// The reply may have been deleted, and its purge run, while it was being indexed.
+	if indexed {
+		deleted, err := c.postsRepo.IsPostDeleted(ctx, reply.Id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if deleted {
+			if _, err := c.postsRepo.RemoveReplyFromParent(ctx, reply); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			indexed = false
+		}
+	}
+
+	if indexed {
+		if err := c.syncEditedIndexes(ctx, reply.Id); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return connect.NewResponse(&postsv1.CreateReplyIndexedByPostResponse{
+		Indexed: indexed,
+	}), nil
+}
+
+// ---------------- Reposts ------------------
+func (c *PostController) Repost(
+	ctx context.Context,
+	req *connect.Request[postsv1.RepostRequest],
+) (*connect.Response[postsv1.RepostResponse], error) {
+	if req.Msg.GetPostId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	post, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	visible, err := c.filterVisible(ctx, user.Id, []*postsv1.Post{post})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(visible) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
+	}
+
+	// A repost is shown to everyone, which would widen a restricted audience.
+	if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("close friends posts can't be reposted"))
+	}
+
+	repostId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate snowflake id: %w", err))
+	}
+
+	repost, created, err := c.postsRepo.CreateRepost(ctx, &postsv1.Repost{
+		PostId:    post.Id,
+		UserId:    user.Id,
+		RepostId:  int64(repostId),
+		CreatedAt: timestamppb.Now(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to repost: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.RepostResponse{
+		Repost:  repost,
+		Created: created,
+	}), nil
+}
+
+// UndoRepost is idempotent: undoing a repost that doesn't exist succeeds.
+func (c *PostController) UndoRepost(
+	ctx context.Context,
+	req *connect.Request[postsv1.UndoRepostRequest],
+) (*connect.Response[postsv1.UndoRepostResponse], error) {
+	if req.Msg.GetPostId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	if _, err := c.postsRepo.DeleteRepost(ctx, req.Msg.GetPostId(), user.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to undo repost: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.UndoRepostResponse{
+		Success: true,
+	}), nil
+}
+
+func (c *PostController) IncrementPostReposts(
+	ctx context.Context,
+	req *connect.Request[postsv1.IncrementPostRepostsRequest],
+) (*connect.Response[postsv1.IncrementPostRepostsResponse], error) {
+	if req.Msg.GetPostId() == 0 || req.Msg.GetEventId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id and event_id are required"))
+	}
+
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, req.Msg.GetPostId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted {
+		return connect.NewResponse(&postsv1.IncrementPostRepostsResponse{}), nil
+	}
+
+	incremented, err := c.postsRepo.IncrementEngagementCountOnce(ctx, req.Msg.GetPostId(), "repost_count", req.Msg.GetEventId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to increment repost count: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.IncrementPostRepostsResponse{
+		Incremented: incremented,
+	}), nil
+}
+
+func (c *PostController) DecrementPostReposts(
+	ctx context.Context,
+	req *connect.Request[postsv1.DecrementPostRepostsRequest],
+) (*connect.Response[postsv1.DecrementPostRepostsResponse], error) {
+	if req.Msg.GetPostId() == 0 || req.Msg.GetEventId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id and event_id are required"))
+	}
+
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, req.Msg.GetPostId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if deleted {
+		return connect.NewResponse(&postsv1.DecrementPostRepostsResponse{}), nil
+	}
+
+	decremented, err := c.postsRepo.DecrementEngagementCountOnce(ctx, req.Msg.GetPostId(), "repost_count", req.Msg.GetEventId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decrement repost count: %w", err))
+	}
+
+	return connect.NewResponse(&postsv1.DecrementPostRepostsResponse{
+		Decremented: decremented,
+	}), nil
+}
+
+// ---------------- Likes ------------------
+func (c *PostController) ListLikesByPost(
+	ctx context.Context,
+	req *connect.Request[postsv1.ListLikesByPostRequest],
+) (*connect.Response[postsv1.ListLikesByPostResponse], error) {
+	if req.Msg.GetPostId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("post_id is required"))
+	}
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxLikePageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxLikePageSize))
+	}
+
+	post, err := c.postsRepo.GetPost(ctx, req.Msg.GetPostId())
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	visible, err := c.filterVisible(ctx, viewerID(ctx), []*postsv1.Post{post})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(visible) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("post not found"))
+	}
+
+	userIDs, nextPage, err := c.postsRepo.ListLikesByPost(ctx, req.Msg.GetPostId(), req.Msg.GetPageSize(), req.Msg.GetPagingState())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	users := make([]*userv1.User, 0, len(userIDs))
+	if len(userIDs) > 0 {
+		found, err := c.publicUsers(ctx, req.Header(), userIDs)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to get likers: %w", err))
+		}
+		for _, id := range userIDs {
+			if user, ok := found[id]; ok {
+				users = append(users, user)
+			}
+		}
+	}
+
+	return connect.NewResponse(&postsv1.ListLikesByPostResponse{
+		Users:       users,
+		PagingState: nextPage,
+	}), nil
+}
+
+func (c *PostController) ListLikedPostsByUser(
+	ctx context.Context,
+	req *connect.Request[postsv1.ListLikedPostsByUserRequest],
+) (*connect.Response[postsv1.ListLikedPostsByUserResponse], error) {
+	if req.Msg.GetUserId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxLikePageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxLikePageSize))
+	}
+
+	likes, nextPage, err := c.postsRepo.ListLikedPostsByUser(ctx, req.Msg.GetUserId(), req.Msg.GetPageSize(), req.Msg.GetPagingState())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	ids := make([]int64, len(likes))
+	for i, like := range likes {
+		ids[i] = like.PostId
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get liked posts: %w", err))
+	}
+
+	// Keep the liked_at order; posts deleted since they were liked drop out.
+	posts := make([]*postsv1.Post, 0, len(found))
+	for _, id := range ids {
+		if post, ok := found[id]; ok {
+			posts = append(posts, post)
+		}
+	}
+
+	viewer := viewerID(ctx)
+
+	posts, err = c.filterVisible(ctx, viewer, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	posts, err = c.embedPosts(ctx, viewer, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	states, err := c.viewerStates(ctx, viewer, posts...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), posts...)
+
+	return connect.NewResponse(&postsv1.ListLikedPostsByUserResponse{
+		Posts:        posts,
+		PagingState:  nextPage,
+		ViewerStates: states,
+	}), nil
+}
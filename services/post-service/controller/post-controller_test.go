@@ -0,0 +1,317 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/gen/user/v1/userv1connect"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
+)
+
+// fakeUserClient answers BatchGetUsers from users; the other methods are not used.
+type fakeUserClient struct {
+	userv1connect.UserServiceClient
+	users []*userv1.User
+	err   error
+	auth  string // Authorization header of the last call
+}
+
+func (f *fakeUserClient) BatchGetUsers(_ context.Context, req *connect.Request[userv1.BatchGetUsersRequest]) (*connect.Response[userv1.BatchGetUsersResponse], error) {
+	f.auth = req.Header().Get("Authorization")
+	if f.err != nil {
+		return nil, f.err
+	}
+	return connect.NewResponse(&userv1.BatchGetUsersResponse{Users: f.users}), nil
+}
+
+func TestHydrateUsers(t *testing.T) {
+	ann := &userv1.User{Id: 1, Username: "ann", FullName: "Ann", Email: "ann@example.com", Bio: "hello"}
+	bob := &userv1.User{Id: 2, Username: "bob", IsVerified: true}
+
+	tests := []struct {
+		name      string
+		client    *fakeUserClient
+		wantNames []string // post author, embedded post author
+	}{
+		{"hydrates posts and embeds", &fakeUserClient{users: []*userv1.User{ann, bob}}, []string{"ann", "bob"}},
+		{"unknown users keep their ids", &fakeUserClient{users: []*userv1.User{ann}}, []string{"ann", ""}},
+		{"user-service down", &fakeUserClient{err: errors.New("unavailable")}, []string{"", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &PostController{userClient: tt.client}
+			post := &postsv1.Post{
+				Id:   10,
+				User: &userv1.User{Id: 1},
+				EmbeddedPost: &postsv1.Post{
+					Id:   11,
+					User: &userv1.User{Id: 2},
+				},
+			}
+
+			header := http.Header{}
+			header.Set("Authorization", "Bearer token")
+			c.hydrateUsers(context.Background(), header, post, nil)
+
+			if tt.client.auth != "Bearer token" {
+				t.Errorf("Authorization = %q, want it forwarded", tt.client.auth)
+			}
+			got := []string{post.User.Username, post.EmbeddedPost.User.Username}
+			if got[0] != tt.wantNames[0] || got[1] != tt.wantNames[1] {
+				t.Errorf("usernames = %v, want %v", got, tt.wantNames)
+			}
+			if post.User.Id != 1 || post.EmbeddedPost.User.Id != 2 {
+				t.Errorf("user ids = %d, %d, want 1, 2", post.User.Id, post.EmbeddedPost.User.Id)
+			}
+			if post.User.Email != "" || post.User.Bio != "" {
+				t.Errorf("hydrated user has private fields: %v", post.User)
+			}
+		})
+	}
+}
+
+func TestFilterVisibleWithoutListLookup(t *testing.T) {
+	public := &postsv1.Post{Id: 1, User: &userv1.User{Id: 7}}
+	closeFriends := &postsv1.Post{Id: 2, User: &userv1.User{Id: 7}, Audience: postsv1.Audience_AUDIENCE_CLOSE_FRIENDS}
+
+	// Neither case reads close_friends_by_user, so the controller needs no repository.
+	tests := []struct {
+		name   string
+		viewer int64
+		want   []int64
+	}{
+		{"anonymous viewer", 0, []int64{1}},
+		{"author", 7, []int64{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible, err := (&PostController{}).filterVisible(context.Background(), tt.viewer, []*postsv1.Post{public, closeFriends})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int64
+			for _, p := range visible {
+				got = append(got, p.Id)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("visible posts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThreadPage(t *testing.T) {
+	// Conversation 1: 2 and 4 reply to 1, 3 to 2, 5 to 3 and 6 to 4.
+	rows := []repository.ThreadReply{
+		{ID: 2, ParentID: 1}, {ID: 3, ParentID: 2}, {ID: 4, ParentID: 1}, {ID: 5, ParentID: 3}, {ID: 6, ParentID: 4},
+	}
+	root := &postsv1.Post{Id: 1}
+	reply := &postsv1.Post{Id: 2, RootPostId: 1, ReplyToPostId: 1}
+
+	tests := []struct {
+		name     string
+		post     *postsv1.Post
+		size     int
+		afterId  int64
+		want     []int64
+		wantNext int64 // 0 when the scan runs out before the page fills
+	}{
+		{"whole conversation", root, 10, 0, []int64{2, 3, 4, 5, 6}, 0},
+		{"subtree of a reply", reply, 10, 0, []int64{3, 5}, 0},
+		{"reply before the cursor keeps its descendants", reply, 10, 3, []int64{5}, 0},
+		{"full page", root, 2, 0, []int64{2, 3}, 3},
+		{"second page", root, 2, 3, []int64{4, 5}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := newThreadPage(tt.post, tt.size, tt.afterId)
+			for _, row := range rows {
+				if page.add(row) {
+					break
+				}
+			}
+
+			if !slices.Equal(page.ids, tt.want) {
+				t.Errorf("ids = %v, want %v", page.ids, tt.want)
+			}
+			var next int64
+			if page.nextPage != nil {
+				var err error
+				if next, err = decodeTimelineCursor(page.nextPage); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if next != tt.wantNext {
+				t.Errorf("next page after %d, want %d", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestAttachEmbeds(t *testing.T) {
+	original := &postsv1.Post{Id: 1}
+
+	tests := []struct {
+		name      string
+		post      *postsv1.Post
+		originals []*postsv1.Post
+		wantKept  bool
+		wantEmbed *postsv1.Post
+	}{
+		{"plain post", &postsv1.Post{Id: 10}, nil, true, nil},
+		{"repost", &postsv1.Post{Id: 10, RepostOfPostId: 1}, []*postsv1.Post{original}, true, original},
+		{"repost of a hidden or deleted post", &postsv1.Post{Id: 10, RepostOfPostId: 1}, nil, false, nil},
+		{"quote", &postsv1.Post{Id: 10, QuotePostId: 1}, []*postsv1.Post{original}, true, original},
+		{"quote of a hidden or deleted post", &postsv1.Post{Id: 10, QuotePostId: 1}, nil, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept := attachEmbeds([]*postsv1.Post{tt.post}, tt.originals)
+			if got := len(kept) == 1; got != tt.wantKept {
+				t.Fatalf("kept = %v, want %v", got, tt.wantKept)
+			}
+			if tt.wantKept && kept[0].EmbeddedPost != tt.wantEmbed {
+				t.Errorf("embedded post = %v, want %v", kept[0].EmbeddedPost, tt.wantEmbed)
+			}
+		})
+	}
+}
+
+func TestRepostCounterEventsNeedEventID(t *testing.T) {
+	// Rejected before any read, so the controller needs no repository.
+	c := &PostController{}
+	ctx := context.Background()
+
+	if _, err := c.IncrementPostReposts(ctx, connect.NewRequest(&postsv1.IncrementPostRepostsRequest{PostId: 1})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("IncrementPostReposts without event id: %v, want InvalidArgument", err)
+	}
+	if _, err := c.DecrementPostReposts(ctx, connect.NewRequest(&postsv1.DecrementPostRepostsRequest{PostId: 1})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("DecrementPostReposts without event id: %v, want InvalidArgument", err)
+	}
+}
+
+func TestDeleteLikeChecksCaller(t *testing.T) {
+	c := &PostController{}
+	signedIn := context.WithValue(context.Background(), auth.UserContextKey, &userv1.User{Id: 2})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *postsv1.DeleteLikeRequest
+		want connect.Code
+	}{
+		{"missing post", signedIn, &postsv1.DeleteLikeRequest{UserId: 2}, connect.CodeInvalidArgument},
+		{"signed out", context.Background(), &postsv1.DeleteLikeRequest{PostId: 1, UserId: 2}, connect.CodeUnauthenticated},
+		{"someone else's like", signedIn, &postsv1.DeleteLikeRequest{PostId: 1, UserId: 3}, connect.CodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.DeleteLike(tt.ctx, connect.NewRequest(tt.req)); connect.CodeOf(err) != tt.want {
+				t.Errorf("DeleteLike() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := c.DeleteLikeByUser(context.Background(), connect.NewRequest(&postsv1.DeleteLikeByUserRequest{Like: &postsv1.Like{PostId: 1}})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("DeleteLikeByUser without event id: %v, want InvalidArgument", err)
+	}
+}
+
+func TestListLikesPageSize(t *testing.T) {
+	// Rejected before any read, so the controller needs no repository.
+	c := &PostController{}
+	ctx := context.Background()
+
+	for _, size := range []int32{0, -1, maxLikePageSize + 1} {
+		if _, err := c.ListLikesByPost(ctx, connect.NewRequest(&postsv1.ListLikesByPostRequest{PostId: 1, PageSize: size})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ListLikesByPost with page size %d: %v, want InvalidArgument", size, err)
+		}
+		if _, err := c.ListLikedPostsByUser(ctx, connect.NewRequest(&postsv1.ListLikedPostsByUserRequest{UserId: 1, PageSize: size})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ListLikedPostsByUser with page size %d: %v, want InvalidArgument", size, err)
+		}
+	}
+}
+
+func TestEmptyViewerStates(t *testing.T) {
+	original := &postsv1.Post{Id: 1}
+	posts := []*postsv1.Post{
+		original,
+		{Id: 2, RepostOfPostId: 1, EmbeddedPost: original},
+		{Id: 3, QuotePostId: 1, EmbeddedPost: original},
+		nil,
+	}
+
+	states, ids := emptyViewerStates(posts)
+	if want := []int64{1, 2, 3}; !slices.Equal(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+	for _, id := range ids {
+		if state := states[id]; state == nil || state.Liked || state.Reposted {
+			t.Errorf("state of post %d = %v, want an empty state", id, state)
+		}
+	}
+}
+
+func TestViewerStatesAnonymous(t *testing.T) {
+	// Anonymous viewers are answered without reading likes or reposts.
+	states, err := (&PostController{}).viewerStates(context.Background(), 0, &postsv1.Post{Id: 1}, &postsv1.Post{Id: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(states) != 2 || states[1] == nil || states[2] == nil {
+		t.Errorf("states = %v, want empty states for posts 1 and 2", states)
+	}
+}
+
+func TestPostDeletedEventsNeedThePost(t *testing.T) {
+	// Rejected before any write, so the controller needs no repository.
+	c := &PostController{}
+	ctx := context.Background()
+
+	for _, post := range []*postsv1.Post{{}, {Id: 1}, {User: &userv1.User{Id: 7}}} {
+		if err := c.DeletePostCopies(ctx, post); err == nil {
+			t.Errorf("DeletePostCopies(%v) succeeded, want an error", post)
+		}
+	}
+
+	// Without the event id a redelivered post.deleted would take the post off the
+	// count twice.
+	if _, err := c.DecrementUserPostCount(ctx, connect.NewRequest(&postsv1.DecrementUserPostCountRequest{UserId: 7})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("DecrementUserPostCount without event id: %v, want InvalidArgument", err)
+	}
+}
+
+func TestDeletePostChecksCaller(t *testing.T) {
+	c := &PostController{}
+	signedIn := context.WithValue(context.Background(), auth.UserContextKey, &userv1.User{Id: 7})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *postsv1.DeletePostRequest
+		want connect.Code
+	}{
+		{"missing post", signedIn, &postsv1.DeletePostRequest{}, connect.CodeInvalidArgument},
+		{"signed out", context.Background(), &postsv1.DeletePostRequest{PostId: 1}, connect.CodeUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.DeletePost(tt.ctx, connect.NewRequest(tt.req)); connect.CodeOf(err) != tt.want {
+				t.Errorf("DeletePost() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
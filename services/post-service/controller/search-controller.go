@@ -0,0 +1,276 @@
+package controller
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/search"
+	"github.com/yaninyzwitty/threads-go-backend/shared/snowflake"
+	"github.com/yaninyzwitty/threads-go-backend/shared/tags"
+)
+
+const (
+	maxSearchPageSize    = 50
+	maxSearchQueryLength = 256 // characters
+	maxSearchTerms       = 16  // terms across words and phrases
+
+	searchBackfillPageSize = 500 // posts read per page while backfilling the index
+)
+
+// ---------------- Search ------------------
+
+// SearchPosts finds public posts by content. All words must match, quoted phrases must
+// match in order, and results can be narrowed by author, creation time and hashtag.
+func (c *PostController) SearchPosts(
+	ctx context.Context,
+	req *connect.Request[postsv1.SearchPostsRequest],
+) (*connect.Response[postsv1.SearchPostsResponse], error) {
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxSearchPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxSearchPageSize))
+	}
+	if utf8.RuneCountInString(req.Msg.GetQuery()) > maxSearchQueryLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query is longer than %d characters", maxSearchQueryLength))
+	}
+
+	q, err := searchQuery(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	hits, more, err := c.searchIndex.Search(ctx, q)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to search posts: %w", err))
+	}
+
+	postIds := make([]int64, len(hits))
+	for i, hit := range hits {
+		postIds[i] = hit.PostID
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, postIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get matching posts: %w", err))
+	}
+
+	// The index can lag behind deletes.
+	posts := make([]*postsv1.Post, 0, len(found))
+	for _, id := range postIds {
+		if post, ok := found[id]; ok {
+			posts = append(posts, post)
+		}
+	}
+
+	viewer := viewerID(ctx)
+
+	posts, err = c.filterVisible(ctx, viewer, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	posts, err = c.embedPosts(ctx, viewer, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	states, err := c.viewerStates(ctx, viewer, posts...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), posts...)
+
+	var nextCursor []byte
+	if more {
+		last := hits[len(hits)-1]
+		nextCursor = encodeSearchCursor(search.Cursor{Score: last.Score, PostID: last.PostID})
+	}
+
+	return connect.NewResponse(&postsv1.SearchPostsResponse{
+		Posts:        posts,
+		Cursor:       nextCursor,
+		ViewerStates: states,
+	}), nil
+}
+
+// searchQuery turns a request into an index query. Date filters become post id bounds.
+func searchQuery(msg *postsv1.SearchPostsRequest) (search.Query, error) {
+	terms, phrases := search.ParseQuery(msg.GetQuery())
+
+	count := len(terms)
+	for _, phrase := range phrases {
+		count += len(phrase)
+	}
+	if count > maxSearchTerms {
+		return search.Query{}, fmt.Errorf("at most %d search terms", maxSearchTerms)
+	}
+
+	filters := msg.GetFilters()
+
+	var hashtag string
+	if filters.GetHashtag() != "" {
+		var err error
+		if hashtag, err = tags.Normalize(filters.GetHashtag()); err != nil {
+			return search.Query{}, err
+		}
+	}
+
+	if count == 0 && hashtag == "" && filters.GetAuthorId() == 0 {
+		return search.Query{}, errors.New("query, author or hashtag is required")
+	}
+
+	q := search.Query{
+		Terms:    terms,
+		Phrases:  phrases,
+		AuthorID: filters.GetAuthorId(),
+		Hashtag:  hashtag,
+		Limit:    int(msg.GetPageSize()),
+	}
+
+	if filters.GetSince() != nil {
+		q.MinID = snowflake.IDAt(filters.GetSince().AsTime())
+	}
+	if filters.GetUntil() != nil {
+		q.MaxID = snowflake.IDAt(filters.GetUntil().AsTime())
+		if q.MaxID <= q.MinID {
+			return search.Query{}, errors.New("until must be after since")
+		}
+	}
+
+	switch msg.GetSort() {
+	case postsv1.SearchSort_SEARCH_SORT_UNSPECIFIED, postsv1.SearchSort_SEARCH_SORT_RELEVANCE:
+		q.Sort = search.SortRelevance
+	case postsv1.SearchSort_SEARCH_SORT_RECENCY:
+		q.Sort = search.SortRecency
+	default:
+		return search.Query{}, errors.New("invalid sort")
+	}
+
+	cursor, err := decodeSearchCursor(msg.GetCursor())
+	if err != nil {
+		return search.Query{}, err
+	}
+	q.After = cursor
+
+	return q, nil
+}
+
+// IndexPostForSearch applies a post.created or post.edited event to the search index.
+// Close friends posts are never indexed. Like the other search event handlers it is
+// called by the kafka consumer and is not part of PostService.
+func (c *PostController) IndexPostForSearch(ctx context.Context, post *postsv1.Post) error {
+	if post.GetId() == 0 || post.User.GetId() == 0 || post.CreatedAt == nil {
+		return errors.New("post with id, user and created_at is required")
+	}
+
+	if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		return nil
+	}
+
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, post.Id)
+	if err != nil || deleted {
+		return err
+	}
+
+	if err := c.searchIndex.Upsert(ctx, searchDocument(post)); err != nil {
+		return fmt.Errorf("failed to index post %d: %w", post.Id, err)
+	}
+
+	// A post.deleted handled alongside may have removed the document before it was added.
+	deleted, err = c.postsRepo.IsPostDeleted(ctx, post.Id)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		if err := c.searchIndex.Delete(ctx, post.Id); err != nil {
+			return fmt.Errorf("failed to unindex post %d: %w", post.Id, err)
+		}
+	}
+	return nil
+}
+
+// BackfillSearchIndex adds every post in the posts table to the search index, for
+// backends that do not keep their documents across restarts. Events handled while it
+// runs are safe: upserts are versioned, and searches skip posts deleted since.
+// It returns the number of posts indexed.
+func (c *PostController) BackfillSearchIndex(ctx context.Context) (int, error) {
+	indexed := 0
+
+	var pageState []byte
+	for {
+		posts, next, err := c.postsRepo.ScanPosts(ctx, searchBackfillPageSize, pageState)
+		if err != nil {
+			return indexed, err
+		}
+
+		for _, post := range posts {
+			if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+				continue
+			}
+			if err := c.searchIndex.Upsert(ctx, searchDocument(post)); err != nil {
+				return indexed, fmt.Errorf("failed to index post %d: %w", post.Id, err)
+			}
+			indexed++
+		}
+
+		if len(next) == 0 {
+			return indexed, nil
+		}
+		pageState = next
+	}
+}
+
+// searchDocument is the search index document for a post, versioned by its last edit.
+func searchDocument(post *postsv1.Post) search.Document {
+	version := post.CreatedAt.AsTime()
+	if post.EditedAt != nil {
+		version = post.EditedAt.AsTime()
+	}
+
+	return search.Document{
+		PostID:   post.Id,
+		AuthorID: post.User.GetId(),
+		Content:  post.Content,
+		Hashtags: post.Hashtags,
+		Version:  version.UnixMicro(),
+	}
+}
+
+// RemovePostFromSearch applies a post.deleted event to the search index.
+func (c *PostController) RemovePostFromSearch(ctx context.Context, postId int64) error {
+	if postId == 0 {
+		return errors.New("post_id is required")
+	}
+
+	if err := c.searchIndex.Delete(ctx, postId); err != nil {
+		return fmt.Errorf("failed to unindex post %d: %w", postId, err)
+	}
+	return nil
+}
+
+// Search cursors are the last hit's score as IEEE 754 bits followed by its post id,
+// both 8-byte big-endian.
+func encodeSearchCursor(cursor search.Cursor) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], math.Float64bits(cursor.Score))
+	binary.BigEndian.PutUint64(b[8:], uint64(cursor.PostID))
+	return b
+}
+
+func decodeSearchCursor(b []byte) (search.Cursor, error) {
+	if len(b) == 0 {
+		return search.Cursor{}, nil
+	}
+	if len(b) != 16 {
+		return search.Cursor{}, errors.New("invalid cursor")
+	}
+	return search.Cursor{
+		Score:  math.Float64frombits(binary.BigEndian.Uint64(b[:8])),
+		PostID: int64(binary.BigEndian.Uint64(b[8:])),
+	}, nil
+}
@@ -0,0 +1,541 @@
+package controller
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	maxTimelinePageSize   = 100
+	fanOutPageSize        = 1000                // followers read per page while fanning out
+	timelineBackfillLimit = 20                  // recent posts copied into a new follower's timeline
+	timelinePruneLimit    = 200                 // recent posts removed from a former follower's timeline
+	timelineWindow        = 30 * 24 * time.Hour // matches home_timeline_by_user's TTL
+
+	// Read-time merge of authors that skipped fan-out.
+	maxMergedFollowing   = 5000 // followed accounts checked for skipped fan-out
+	mergedRecentLimit    = 100  // recent posts kept per merged author
+	mergedAuthorsTTL     = 5 * time.Minute
+	mergedRecentPostsTTL = time.Minute
+)
+
+// ---------------- Home Timeline ------------------
+func (c *PostController) GetHomeTimeline(
+	ctx context.Context,
+	req *connect.Request[postsv1.GetHomeTimelineRequest],
+) (*connect.Response[postsv1.GetHomeTimelineResponse], error) {
+	if req.Msg.GetPageSize() <= 0 || req.Msg.GetPageSize() > maxTimelinePageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxTimelinePageSize))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	beforeId, err := decodeTimelineCursor(req.Msg.GetPagingState())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	limit := int(req.Msg.GetPageSize())
+
+	var (
+		fannedOut []int64
+		merged    [][]int64
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		fannedOut, err = c.postsRepo.ListHomeTimeline(gctx, user.Id, beforeId, limit)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		merged, err = c.mergedTimelinePosts(gctx, user.Id, beforeId, limit)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read timeline: %w", err))
+	}
+
+	postIDs := mergeNewestFirst(limit, append(merged, fannedOut)...)
+
+	var nextPage []byte
+	if len(postIDs) == limit {
+		nextPage = encodeTimelineCursor(postIDs[len(postIDs)-1])
+	}
+
+	found, err := c.postsRepo.GetPostsByIDs(ctx, postIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get timeline posts: %w", err))
+	}
+
+	// Keep timeline order; posts deleted since the fan-out drop out.
+	posts := make([]*postsv1.Post, 0, len(found))
+	for _, id := range postIDs {
+		if post, ok := found[id]; ok {
+			posts = append(posts, post)
+		}
+	}
+
+	// Close friends lists can change after the fan-out.
+	posts, err = c.filterVisible(ctx, user.Id, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	posts, err = c.embedPosts(ctx, user.Id, posts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	states, err := c.viewerStates(ctx, user.Id, posts...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	c.hydrateUsers(ctx, req.Header(), posts...)
+
+	return connect.NewResponse(&postsv1.GetHomeTimelineResponse{
+		Posts:        posts,
+		PagingState:  nextPage,
+		ViewerStates: states,
+	}), nil
+}
+
+// FanOutPost applies a post.created event: it writes the post into its author's
+// timeline and the timelines of everyone who may see it, followers for public posts and
+// the close friends list for close friends posts. Followers are read and written a page
+// at a time. It returns the number of timelines written. Like the other event handlers
+// in this file it is called by the kafka consumer and is not part of PostService.
+func (c *PostController) FanOutPost(ctx context.Context, post *postsv1.Post) (int, error) {
+	if post.GetId() == 0 || post.User.GetId() == 0 {
+		return 0, errors.New("post with id and user is required")
+	}
+
+	// Replies are read in their thread, not in home feeds.
+	if post.ReplyToPostId != 0 {
+		return 0, nil
+	}
+
+	deleted, err := c.postsRepo.IsPostDeleted(ctx, post.Id)
+	if err != nil {
+		return 0, err
+	}
+	if deleted {
+		return 0, nil
+	}
+
+	authorId := post.User.Id
+
+	recipients := c.postsRepo.ListFollowerIDs
+	if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		recipients = c.postsRepo.ListCloseFriendIDs
+	}
+
+	if err := c.postsRepo.AddToHomeTimelines(ctx, []int64{authorId}, post.Id, authorId); err != nil {
+		return 0, err
+	}
+	delivered := 1
+
+	// Large audiences are merged in at read time instead. Close friends lists are
+	// small enough to always fan out.
+	if c.celebrityThreshold > 0 && post.Audience != postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		followers, err := c.postsRepo.GetFollowerCount(ctx, authorId)
+		if err != nil {
+			return 0, err
+		}
+		if followers >= c.celebrityThreshold {
+			if err := c.postsRepo.MarkFanOutSkipped(ctx, authorId, time.Now()); err != nil {
+				return 0, err
+			}
+			return delivered, nil
+		}
+	}
+
+	var pageState []byte
+	for {
+		userIds, next, err := recipients(ctx, authorId, fanOutPageSize, pageState)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := c.postsRepo.AddToHomeTimelines(ctx, userIds, post.Id, authorId); err != nil {
+			return 0, err
+		}
+		delivered += len(userIds)
+
+		if len(next) == 0 {
+			break
+		}
+		pageState = next
+	}
+
+	// A post.deleted retraction running alongside this fan-out can miss timelines
+	// written after it read the followers, so take the post back out here.
+	deleted, err = c.postsRepo.IsPostDeleted(ctx, post.Id)
+	if err != nil {
+		return 0, err
+	}
+	if deleted {
+		_, err := c.RetractFanOut(ctx, post)
+		return 0, err
+	}
+
+	return delivered, nil
+}
+
+// RetractFanOut applies a post.deleted event: it deletes the post from its author's
+// timeline and from the same audience FanOutPost delivers to. Authors over the
+// celebrity threshold were merged at read time, which already drops deleted posts.
+// Entries missed because the audience changed since the fan-out are skipped by
+// GetHomeTimeline and expire with the table TTL.
+func (c *PostController) RetractFanOut(ctx context.Context, post *postsv1.Post) (int, error) {
+	if post.GetId() == 0 || post.User.GetId() == 0 {
+		return 0, errors.New("post with id and user is required")
+	}
+	if post.ReplyToPostId != 0 {
+		return 0, nil
+	}
+
+	authorId := post.User.Id
+
+	if err := c.postsRepo.RemoveFromHomeTimelines(ctx, []int64{authorId}, post.Id); err != nil {
+		return 0, err
+	}
+	removed := 1
+
+	if c.celebrityThreshold > 0 && post.Audience != postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		followers, err := c.postsRepo.GetFollowerCount(ctx, authorId)
+		if err != nil {
+			return 0, err
+		}
+		if followers >= c.celebrityThreshold {
+			return removed, nil
+		}
+	}
+
+	recipients := c.postsRepo.ListFollowerIDs
+	if post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+		recipients = c.postsRepo.ListCloseFriendIDs
+	}
+
+	var pageState []byte
+	for {
+		userIds, next, err := recipients(ctx, authorId, fanOutPageSize, pageState)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := c.postsRepo.RemoveFromHomeTimelines(ctx, userIds, post.Id); err != nil {
+			return 0, err
+		}
+		removed += len(userIds)
+
+		if len(next) == 0 {
+			break
+		}
+		pageState = next
+	}
+
+	return removed, nil
+}
+
+// BackfillHomeTimeline applies a user.followed event by copying the author's recent
+// posts into the follower's timeline. Follow events can be processed out of order, so
+// it goes by the current follow state rather than the event: nothing is copied once
+// the user has unfollowed again, and the copy is taken back out if an unfollow lands
+// while it is being written. It returns the number of posts added.
+func (c *PostController) BackfillHomeTimeline(ctx context.Context, userId, authorId int64) (int, error) {
+	if userId == 0 || authorId == 0 {
+		return 0, errors.New("user_id and author_id are required")
+	}
+
+	following, err := c.postsRepo.IsFollowing(ctx, userId, authorId)
+	if err != nil || !following {
+		return 0, err
+	}
+
+	recent, err := c.postsRepo.ListPostsByUser(ctx, authorId, timelineBackfillLimit, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	postIds := backfillPostIDs(recent.Posts, time.Now().Add(-timelineWindow))
+
+	if err := c.postsRepo.AddPostsToHomeTimeline(ctx, userId, authorId, postIds); err != nil {
+		return 0, err
+	}
+
+	following, err = c.postsRepo.IsFollowing(ctx, userId, authorId)
+	if err != nil {
+		return 0, err
+	}
+	if !following {
+		return 0, c.postsRepo.RemoveFromHomeTimeline(ctx, userId, postIds)
+	}
+
+	return len(postIds), nil
+}
+
+// backfillPostIDs picks the posts a new follower gets copied in: the same rules as
+// FanOutPost, except that close friends posts are left out because following says
+// nothing about list membership. Posts from before cutoff are left out as well.
+func backfillPostIDs(posts []*postsv1.Post, cutoff time.Time) []int64 {
+	var postIds []int64
+	for _, post := range posts {
+		if post.ReplyToPostId != 0 || post.RepostOfPostId != 0 ||
+			post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS ||
+			post.CreatedAt.AsTime().Before(cutoff) {
+			continue
+		}
+		postIds = append(postIds, post.Id)
+	}
+	return postIds
+}
+
+// PruneHomeTimeline applies a user.unfollowed event by removing the author's recent
+// posts from the former follower's timeline. Like BackfillHomeTimeline it goes by the
+// current follow state: a user who has followed again keeps the posts, and a follow
+// that lands while the prune runs is backfilled again. It returns the number of posts
+// removed.
+func (c *PostController) PruneHomeTimeline(ctx context.Context, userId, authorId int64) (int, error) {
+	if userId == 0 || authorId == 0 {
+		return 0, errors.New("user_id and author_id are required")
+	}
+
+	following, err := c.postsRepo.IsFollowing(ctx, userId, authorId)
+	if err != nil || following {
+		return 0, err
+	}
+
+	// Older entries are left to expire with the table TTL.
+	recent, err := c.postsRepo.ListPostsByUser(ctx, authorId, timelinePruneLimit, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	postIds := make([]int64, 0, len(recent.Posts))
+	for _, post := range recent.Posts {
+		postIds = append(postIds, post.Id)
+	}
+
+	if err := c.postsRepo.RemoveFromHomeTimeline(ctx, userId, postIds); err != nil {
+		return 0, err
+	}
+
+	following, err = c.postsRepo.IsFollowing(ctx, userId, authorId)
+	if err != nil {
+		return 0, err
+	}
+	if following {
+		_, err := c.BackfillHomeTimeline(ctx, userId, authorId)
+		return 0, err
+	}
+
+	return len(postIds), nil
+}
+
+// mergedTimelinePosts returns, per followed author whose posts skipped fan-out, up to
+// limit of their recent public post ids older than beforeId, newest first.
+func (c *PostController) mergedTimelinePosts(ctx context.Context, userId, beforeId int64, limit int) ([][]int64, error) {
+	authors, err := c.mergedAuthors(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	lists := make([][]int64, len(authors))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(postReadLimit)
+	for i, authorId := range authors {
+		g.Go(func() error {
+			ids, err := c.recentPostIDs(gctx, authorId)
+			if err != nil {
+				return err
+			}
+
+			// ids are newest first; skip to the cursor.
+			start := 0
+			if beforeId != 0 {
+				for start < len(ids) && ids[start] >= beforeId {
+					start++
+				}
+			}
+			page := ids[start:min(start+limit, len(ids))]
+
+			// Only the newest mergedRecentLimit posts are cached; a page reaching past
+			// them continues from Cassandra.
+			if len(page) < limit && len(ids) == mergedRecentLimit {
+				from := ids[len(ids)-1]
+				if beforeId != 0 && beforeId < from {
+					from = beforeId
+				}
+				older, err := c.timelinePostIDs(gctx, authorId, from, limit-len(page))
+				if err != nil {
+					return err
+				}
+				page = append(page[:len(page):len(page)], older...)
+			}
+
+			lists[i] = page
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// mergedAuthors returns the accounts the user follows that have posts which skipped
+// fan-out. The answer is cached briefly per user.
+func (c *PostController) mergedAuthors(ctx context.Context, userId int64) ([]int64, error) {
+	if authors, ok := c.postsRepo.GetCachedMergedAuthors(ctx, userId); ok {
+		return authors, nil
+	}
+
+	var (
+		following []int64
+		pageState []byte
+	)
+	for len(following) < maxMergedFollowing {
+		ids, next, err := c.postsRepo.ListFollowingIDs(ctx, userId, fanOutPageSize, pageState)
+		if err != nil {
+			return nil, err
+		}
+		following = append(following, ids...)
+
+		if len(next) == 0 {
+			break
+		}
+		pageState = next
+	}
+
+	authors, err := c.postsRepo.FanOutSkippedAuthors(ctx, following)
+	if err != nil {
+		return nil, err
+	}
+
+	c.postsRepo.CacheMergedAuthors(ctx, userId, authors, mergedAuthorsTTL)
+	return authors, nil
+}
+
+// recentPostIDs returns up to mergedRecentLimit of an author's recent top-level public
+// post ids, newest first, through a short-lived cache shared by every reader.
+func (c *PostController) recentPostIDs(ctx context.Context, authorId int64) ([]int64, error) {
+	if ids, ok := c.postsRepo.GetCachedRecentPostIDs(ctx, authorId); ok {
+		return ids, nil
+	}
+
+	ids, err := c.timelinePostIDs(ctx, authorId, 0, mergedRecentLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	c.postsRepo.CacheRecentPostIDs(ctx, authorId, ids, mergedRecentPostsTTL)
+	return ids, nil
+}
+
+// timelinePostIDs reads up to limit of an author's top-level public post ids older than
+// beforeId and inside the timeline window, newest first. Zero beforeId starts at the
+// newest post.
+func (c *PostController) timelinePostIDs(ctx context.Context, authorId, beforeId int64, limit int) ([]int64, error) {
+	cutoff := time.Now().Add(-timelineWindow)
+
+	ids := make([]int64, 0, limit)
+	var pageState []byte
+	for {
+		recent, err := c.postsRepo.ListPostsByUserBefore(ctx, authorId, beforeId, int32(limit), pageState)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, post := range recent.Posts {
+			// posts_by_user is newest first, so the rest are outside the window too.
+			if post.CreatedAt.AsTime().Before(cutoff) {
+				return ids, nil
+			}
+			if post.ReplyToPostId != 0 || post.RepostOfPostId != 0 ||
+				post.Audience == postsv1.Audience_AUDIENCE_CLOSE_FRIENDS {
+				continue
+			}
+			if ids = append(ids, post.Id); len(ids) == limit {
+				return ids, nil
+			}
+		}
+
+		if len(recent.PagingState) == 0 {
+			return ids, nil
+		}
+		pageState = recent.PagingState
+	}
+}
+
+// mergeNewestFirst k-way merges id lists that are each sorted newest first (snowflake
+// ids descending) and returns up to limit distinct ids.
+func mergeNewestFirst(limit int, lists ...[]int64) []int64 {
+	h := make(idHeap, 0, len(lists))
+	for _, list := range lists {
+		if len(list) > 0 {
+			h = append(h, list)
+		}
+	}
+	heap.Init(&h)
+
+	merged := make([]int64, 0, limit)
+	for h.Len() > 0 && len(merged) < limit {
+		id := h[0][0]
+		if len(merged) == 0 || merged[len(merged)-1] != id {
+			merged = append(merged, id)
+		}
+
+		if h[0] = h[0][1:]; len(h[0]) == 0 {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+	}
+	return merged
+}
+
+// idHeap is a max-heap of id lists ordered by each list's head.
+type idHeap [][]int64
+
+func (h idHeap) Len() int           { return len(h) }
+func (h idHeap) Less(i, j int) bool { return h[i][0] > h[j][0] }
+func (h idHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *idHeap) Push(x any)        { *h = append(*h, x.([]int64)) }
+func (h *idHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// The timeline cursor is the id of the last post served; the next page starts below it.
+func encodeTimelineCursor(lastId int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(lastId))
+}
+
+func decodeTimelineCursor(cursor []byte) (int64, error) {
+	if len(cursor) == 0 {
+		return 0, nil
+	}
+	if len(cursor) != 8 {
+		return 0, errors.New("invalid paging_state")
+	}
+	return int64(binary.BigEndian.Uint64(cursor)), nil
+}
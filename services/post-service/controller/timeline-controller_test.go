@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMergeNewestFirst(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		lists [][]int64
+		want  []int64
+	}{
+		{"no lists", 10, nil, []int64{}},
+		{"empty lists", 10, [][]int64{{}, nil}, []int64{}},
+		{"single list", 10, [][]int64{{9, 5, 1}}, []int64{9, 5, 1}},
+		{"interleaved", 10, [][]int64{{9, 6, 3}, {8, 5, 2}, {7, 4, 1}}, []int64{9, 8, 7, 6, 5, 4, 3, 2, 1}},
+		{"duplicates across lists", 10, [][]int64{{9, 7, 5}, {9, 5, 3}}, []int64{9, 7, 5, 3}},
+		{"limit", 3, [][]int64{{9, 6, 3}, {8, 5, 2}}, []int64{9, 8, 6}},
+		{"zero limit", 0, [][]int64{{9, 6, 3}}, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeNewestFirst(tt.limit, tt.lists...)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("mergeNewestFirst(%d, %v) = %v, want %v", tt.limit, tt.lists, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimelineCursor(t *testing.T) {
+	for _, id := range []int64{1, 1 << 40, 1<<63 - 1} {
+		got, err := decodeTimelineCursor(encodeTimelineCursor(id))
+		if err != nil || got != id {
+			t.Errorf("cursor round trip of %d = %d, %v", id, got, err)
+		}
+		if n := len(encodeTimelineCursor(id)); n != 8 {
+			t.Errorf("encodeTimelineCursor(%d) is %d bytes, want 8", id, n)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		cursor  []byte
+		want    int64
+		wantErr bool
+	}{
+		{"empty starts at the top", nil, 0, false},
+		{"short", []byte{1, 2, 3}, 0, true},
+		{"long", make([]byte, 9), 0, true},
+		{"big endian", []byte{0, 0, 0, 0, 0, 0, 1, 0}, 256, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeTimelineCursor(tt.cursor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeTimelineCursor(%v) error = %v, wantErr %v", tt.cursor, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("decodeTimelineCursor(%v) = %d, want %d", tt.cursor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackfillPostIDs(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-timelineWindow)
+	at := func(t time.Time) *timestamppb.Timestamp { return timestamppb.New(t) }
+
+	posts := []*postsv1.Post{
+		{Id: 6, CreatedAt: at(now)},
+		{Id: 5, CreatedAt: at(now), ReplyToPostId: 1},
+		{Id: 4, CreatedAt: at(now), RepostOfPostId: 1},
+		{Id: 3, CreatedAt: at(now), Audience: postsv1.Audience_AUDIENCE_CLOSE_FRIENDS},
+		{Id: 2, CreatedAt: at(now), QuotePostId: 1},
+		{Id: 1, CreatedAt: at(cutoff.Add(-time.Second))},
+	}
+
+	if got, want := backfillPostIDs(posts, cutoff), []int64{6, 2}; !slices.Equal(got, want) {
+		t.Errorf("backfillPostIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestTimelineEventsWithoutWork(t *testing.T) {
+	// None of these reach the repository, so the controller needs none.
+	c := &PostController{}
+	ctx := context.Background()
+	reply := &postsv1.Post{Id: 2, User: &userv1.User{Id: 7}, ReplyToPostId: 1}
+
+	tests := []struct {
+		name    string
+		run     func() (int, error)
+		wantErr bool
+	}{
+		{"fan out a reply", func() (int, error) { return c.FanOutPost(ctx, reply) }, false},
+		{"retract a reply", func() (int, error) { return c.RetractFanOut(ctx, reply) }, false},
+		{"fan out without an author", func() (int, error) { return c.FanOutPost(ctx, &postsv1.Post{Id: 1}) }, true},
+		{"backfill without a user", func() (int, error) { return c.BackfillHomeTimeline(ctx, 0, 7) }, true},
+		{"prune without an author", func() (int, error) { return c.PruneHomeTimeline(ctx, 7, 0) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.run()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n != 0 {
+				t.Errorf("wrote %d timelines, want 0", n)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/segmentio/kafka-go"
 	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
 	"github.com/yaninyzwitty/threads-go-backend/services/post-service/controller"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -49,8 +50,179 @@ func StartKafkaConsumer(ctx context.Context, kafkaReader *kafka.Reader, postCont
 				}))
 				return err
 			})
+
+			eg.Go(func() error {
+				slog.Info("fanning out post to home timelines...", "post_id", postCreatedEvent.Id)
+				_, err := postController.FanOutPost(egCtx, &postCreatedEvent)
+				return err
+			})
+
+			eg.Go(func() error {
+				slog.Info("indexing post hashtags...", "post_id", postCreatedEvent.Id)
+				_, err := postController.IndexPostHashtags(egCtx, &postCreatedEvent)
+				return err
+			})
+
+			eg.Go(func() error {
+				slog.Info("indexing post for search...", "post_id", postCreatedEvent.Id)
+				return postController.IndexPostForSearch(egCtx, &postCreatedEvent)
+			})
+
+			eg.Go(func() error {
+				slog.Info("incrementing user post count...", "user_id", postCreatedEvent.User.GetId())
+				_, err := postController.IncrementUserPostCount(egCtx, connect.NewRequest(&postsv1.IncrementUserPostCountRequest{
+					UserId:  postCreatedEvent.User.GetId(),
+					EventId: event.EventId,
+				}))
+				return err
+			})
+			return eg.Wait()
+
+		},
+		"post.replied": func(b []byte) error {
+			slog.Info("handling post.replied event...")
+
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var reply postsv1.Post
+			if err := protojson.Unmarshal([]byte(event.Payload), &reply); err != nil {
+				return fmt.Errorf("failed to unmarshal post replied event payload: %w", err)
+			}
+
+			res, err := postController.CreateReplyIndexedByPost(ctx, connect.NewRequest(&postsv1.CreateReplyIndexedByPostRequest{
+				Reply: &reply,
+			}))
+			if err != nil {
+				return err
+			}
+			if !res.Msg.Indexed {
+				slog.Info("reply already indexed, skipping", "post_id", reply.ReplyToPostId, "reply_id", reply.Id)
+			}
+			return nil
+		},
+		"post.edited": func(b []byte) error {
+			slog.Info("handling post.edited event...")
+
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var edited postsv1.Post
+			if err := protojson.Unmarshal([]byte(event.Payload), &edited); err != nil {
+				return fmt.Errorf("failed to unmarshal post edited event payload: %w", err)
+			}
+
+			// Only the denormalized copies change; engagements and timelines are keyed by id.
+			eg, egCtx := errgroup.WithContext(ctx)
+
+			eg.Go(func() error {
+				return postController.UpdatePostIndexes(egCtx, &edited)
+			})
+
+			eg.Go(func() error {
+				_, err := postController.IndexPostHashtags(egCtx, &edited)
+				return err
+			})
+
+			eg.Go(func() error {
+				return postController.IndexPostForSearch(egCtx, &edited)
+			})
+			return eg.Wait()
+		},
+		"post.deleted": func(b []byte) error {
+			slog.Info("handling post.deleted event...")
+
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var deleted postsv1.Post
+			if err := protojson.Unmarshal([]byte(event.Payload), &deleted); err != nil {
+				return fmt.Errorf("failed to unmarshal post deleted event payload: %w", err)
+			}
+
+			eg, egCtx := errgroup.WithContext(ctx)
+
+			eg.Go(func() error {
+				slog.Info("deleting post copies...", "post_id", deleted.Id)
+				return postController.DeletePostCopies(egCtx, &deleted)
+			})
+
+			eg.Go(func() error {
+				slog.Info("retracting post from home timelines...", "post_id", deleted.Id)
+				_, err := postController.RetractFanOut(egCtx, &deleted)
+				return err
+			})
+
+			eg.Go(func() error {
+				slog.Info("decrementing user post count...", "user_id", deleted.User.GetId())
+				_, err := postController.DecrementUserPostCount(egCtx, connect.NewRequest(&postsv1.DecrementUserPostCountRequest{
+					UserId:  deleted.User.GetId(),
+					EventId: event.EventId,
+				}))
+				return err
+			})
+
+			eg.Go(func() error {
+				slog.Info("removing post from search...", "post_id", deleted.Id)
+				return postController.RemovePostFromSearch(egCtx, deleted.Id)
+			})
 			return eg.Wait()
+		},
+		"post.reposted": func(b []byte) error {
+			slog.Info("handling post.reposted event...")
+
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var repost postsv1.Repost
+			if err := protojson.Unmarshal([]byte(event.Payload), &repost); err != nil {
+				return fmt.Errorf("failed to unmarshal Repost payload: %w", err)
+			}
 
+			res, err := postController.IncrementPostReposts(ctx, connect.NewRequest(&postsv1.IncrementPostRepostsRequest{
+				PostId:  repost.PostId,
+				EventId: event.EventId,
+			}))
+			if err != nil {
+				return err
+			}
+			if !res.Msg.Incremented {
+				slog.Info("repost event already counted, skipping", "event_id", event.EventId, "post_id", repost.PostId)
+			}
+			return nil
+		},
+		"post.unreposted": func(b []byte) error {
+			slog.Info("handling post.unreposted event...")
+
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var repost postsv1.Repost
+			if err := protojson.Unmarshal([]byte(event.Payload), &repost); err != nil {
+				return fmt.Errorf("failed to unmarshal Repost payload: %w", err)
+			}
+
+			res, err := postController.DecrementPostReposts(ctx, connect.NewRequest(&postsv1.DecrementPostRepostsRequest{
+				PostId:  repost.PostId,
+				EventId: event.EventId,
+			}))
+			if err != nil {
+				return err
+			}
+			if !res.Msg.Decremented {
+				slog.Info("unrepost event already counted, skipping", "event_id", event.EventId, "post_id", repost.PostId)
+			}
+			return nil
 		},
 		"like.created": func(b []byte) error {
 			slog.Info("handling like.created event...")
@@ -77,14 +249,75 @@ func StartKafkaConsumer(ctx context.Context, kafkaReader *kafka.Reader, postCont
 
 			eg.Go(func() error {
 				slog.Info("incrementing post likes...")
-				_, err := postController.IncrementPostLikes(egCtx, connect.NewRequest(&postsv1.IncrementPostLikesRequest{
-					PostId: like.PostId,
+				res, err := postController.IncrementPostLikes(egCtx, connect.NewRequest(&postsv1.IncrementPostLikesRequest{
+					PostId:  like.PostId,
+					EventId: event.EventId,
 				}))
-				return err
+				if err != nil {
+					return err
+				}
+				if !res.Msg.Incremented {
+					slog.Info("like event already counted, skipping", "event_id", event.EventId, "post_id", like.PostId)
+				}
+				return nil
 			})
 
 			return eg.Wait()
 		},
+		"like.deleted": func(b []byte) error {
+			slog.Info("handling like.deleted event...")
+
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent: %w", err)
+			}
+
+			var like postsv1.Like
+			if err := protojson.Unmarshal([]byte(event.Payload), &like); err != nil {
+				return fmt.Errorf("failed to unmarshal Like payload: %w", err)
+			}
+
+			res, err := postController.DeleteLikeByUser(ctx, connect.NewRequest(&postsv1.DeleteLikeByUserRequest{
+				Like:    &like,
+				EventId: event.EventId,
+			}))
+			if err != nil {
+				return err
+			}
+			if !res.Msg.Deleted {
+				slog.Info("like.deleted event already applied, skipping", "event_id", event.EventId, "post_id", like.PostId, "user_id", like.UserId)
+			}
+			return nil
+		},
+		// Follows change whose posts belong in the follower's home timeline.
+		"user.followed": func(b []byte) error {
+			var event userv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var followedEvent userv1.FollowedEvent
+			if err := protojson.Unmarshal([]byte(event.Payload), &followedEvent); err != nil {
+				return fmt.Errorf("failed to unmarshal FollowedEvent payload: %w", err)
+			}
+
+			_, err := postController.BackfillHomeTimeline(ctx, followedEvent.UserId, followedEvent.FollowingId)
+			return err
+		},
+		"user.unfollowed": func(b []byte) error {
+			var event userv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var unfollowedEvent userv1.UnfollowedEvent
+			if err := protojson.Unmarshal([]byte(event.Payload), &unfollowedEvent); err != nil {
+				return fmt.Errorf("failed to unmarshal UnfollowedEvent payload: %w", err)
+			}
+
+			_, err := postController.PruneHomeTimeline(ctx, unfollowedEvent.UserId, unfollowedEvent.FollowingId)
+			return err
+		},
 	}
 
 	go func() {
@@ -105,12 +338,11 @@ func StartKafkaConsumer(ctx context.Context, kafkaReader *kafka.Reader, postCont
 				continue
 			}
 
+			// The topic carries every service's events; skip the ones we don't handle.
 			eventKey := parts[0]
 			handler, ok := eventHandlers[eventKey]
 			if !ok {
-				slog.Warn("no handler found for event key", "key", eventKey)
 				continue
-
 			}
 
 			if err := handler(msg.Value); err != nil {
@@ -0,0 +1,196 @@
+package media
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// UploadTokenHeader carries the token returned by CreateUpload.
+const UploadTokenHeader = "X-Upload-Token"
+
+// NewUploadToken returns a random upload token and the hash stored in its place.
+func NewUploadToken() (token, hash string, err error) {
+	token, err = randomHex(32)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate upload token: %w", err)
+	}
+	return token, hashToken(token), nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Handler serves the upload and file endpoints. Uploads are authorized by their token
+// rather than the caller's session, so clients can hand the URL to a background uploader.
+type Handler struct {
+	repo  *repository.PostRepository
+	store BlobStore
+	opts  Options
+}
+
+func NewHandler(repo *repository.PostRepository, store BlobStore, opts Options) *Handler {
+	return &Handler{repo: repo, store: store, opts: opts}
+}
+
+// Upload handles PUT {UploadPath}{id}: the raw file for a media id reserved with
+// CreateUpload. The body must match the reserved content type and size and be a valid
+// image within the dimension limit. Responds with the stored media as JSON.
+func (h *Handler) Upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	mediaId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid media id", http.StatusBadRequest)
+		return
+	}
+
+	upload, err := h.repo.GetMediaUpload(ctx, mediaId)
+	if errors.Is(err, repository.ErrMediaNotFound) {
+		http.Error(w, "upload not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to get upload", "media_id", mediaId, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	token := r.Header.Get(UploadTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(upload.TokenHash)) != 1 {
+		http.Error(w, "invalid upload token", http.StatusForbidden)
+		return
+	}
+	if upload.Status != repository.MediaPending {
+		http.Error(w, "upload already completed", http.StatusConflict)
+		return
+	}
+
+	if r.Header.Get("Content-Type") != upload.ContentType {
+		http.Error(w, fmt.Sprintf("content type must be %s", upload.ContentType), http.StatusUnsupportedMediaType)
+		return
+	}
+	if r.ContentLength > upload.MaxBytes {
+		http.Error(w, "file is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, upload.MaxBytes))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		http.Error(w, "file is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, "failed to read upload", http.StatusBadRequest)
+		return
+	}
+	if len(data) == 0 {
+		http.Error(w, "file is empty", http.StatusBadRequest)
+		return
+	}
+
+	width, height, err := inspectImage(data, upload.ContentType, h.opts.MaxDimension)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	// The random suffix keeps a concurrent upload with the same token from overwriting
+	// the file that wins the status update.
+	suffix, err := randomHex(8)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	key := fmt.Sprintf("%d-%s%s", mediaId, suffix, imageTypes[upload.ContentType].extension)
+
+	if err := h.store.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		slog.Error("failed to store upload", "media_id", mediaId, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	upload.StorageKey = key
+	upload.URL = h.opts.FileURL(key)
+	upload.Width = int32(width)
+	upload.Height = int32(height)
+	upload.SizeBytes = int64(len(data))
+
+	applied, err := h.repo.CompleteMediaUpload(ctx, upload)
+	if err != nil {
+		// The update may still have applied (e.g. a timeout), so the file stays.
+		slog.Error("failed to complete upload", "media_id", mediaId, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if !applied {
+		if err := h.store.Delete(ctx, key); err != nil {
+			slog.Error("failed to delete losing upload", "media_id", mediaId, "key", key, "error", err)
+		}
+		http.Error(w, "upload already completed", http.StatusConflict)
+		return
+	}
+
+	body, err := protojson.Marshal(&postsv1.Media{
+		Id:          upload.ID,
+		Type:        postsv1.MediaType_MEDIA_TYPE_IMAGE,
+		ContentType: upload.ContentType,
+		Url:         upload.URL,
+		Width:       upload.Width,
+		Height:      upload.Height,
+	})
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(body)
+}
+
+// Serve handles GET {FilesPath}{key}. Keys are never reused, so files can be cached
+// indefinitely.
+func (h *Handler) Serve(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+
+	f, err := h.store.Open(r.Context(), key)
+	if errors.Is(err, ErrBlobNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to open media", "key", key, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, key, time.Time{}, f)
+}
@@ -0,0 +1,54 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"net/http"
+
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// imageTypes are the accepted content types with the image.Decode format name and file
+// extension of each.
+var imageTypes = map[string]struct {
+	format    string
+	extension string
+}{
+	"image/jpeg": {"jpeg", ".jpg"},
+	"image/png":  {"png", ".png"},
+	"image/gif":  {"gif", ".gif"},
+}
+
+// Supported reports whether uploads of contentType are accepted.
+func Supported(contentType string) bool {
+	_, ok := imageTypes[contentType]
+	return ok
+}
+
+// inspectImage checks that data is an image of contentType no larger than maxDimension
+// on either side, and returns its size.
+func inspectImage(data []byte, contentType string, maxDimension int) (width, height int, err error) {
+	if detected := http.DetectContentType(data); detected != contentType {
+		return 0, 0, fmt.Errorf("content is %s, not %s", detected, contentType)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid image: %w", err)
+	}
+	if format != imageTypes[contentType].format {
+		return 0, 0, fmt.Errorf("content is %s, not %s", format, contentType)
+	}
+
+	if config.Width <= 0 || config.Height <= 0 {
+		return 0, 0, errors.New("image has no pixels")
+	}
+	if config.Width > maxDimension || config.Height > maxDimension {
+		return 0, 0, fmt.Errorf("image is %dx%d, larger than %dx%d", config.Width, config.Height, maxDimension, maxDimension)
+	}
+	return config.Width, config.Height, nil
+}
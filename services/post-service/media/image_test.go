@@ -0,0 +1,66 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encode(t *testing.T, format string, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("failed to encode %s: %v", format, err)
+	}
+	return buf.Bytes()
+}
+
+func TestInspectImage(t *testing.T) {
+	pngData := encode(t, "png", 40, 30)
+
+	tests := []struct {
+		name          string
+		data          []byte
+		contentType   string
+		maxDimension  int
+		width, height int
+		wantErr       bool
+	}{
+		{"png", pngData, "image/png", 100, 40, 30, false},
+		{"jpeg", encode(t, "jpeg", 16, 8), "image/jpeg", 100, 16, 8, false},
+		{"gif", encode(t, "gif", 5, 7), "image/gif", 100, 5, 7, false},
+		{"at max dimension", pngData, "image/png", 40, 40, 30, false},
+		{"too wide", pngData, "image/png", 39, 0, 0, true},
+		{"too tall", encode(t, "png", 10, 50), "image/png", 49, 0, 0, true},
+		{"wrong content type", pngData, "image/jpeg", 100, 0, 0, true},
+		{"not an image", []byte("hello, world"), "image/png", 100, 0, 0, true},
+		{"truncated", pngData[:20], "image/png", 100, 0, 0, true},
+		{"empty", nil, "image/png", 100, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height, err := inspectImage(tt.data, tt.contentType, tt.maxDimension)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("inspectImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if width != tt.width || height != tt.height {
+				t.Errorf("inspectImage() = %dx%d, want %dx%d", width, height, tt.width, tt.height)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Package media stores uploaded files and serves the HTTP upload and download endpoints
+// of the post-service.
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrBlobNotFound is returned when a key has no stored blob.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore holds uploaded files by key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// HTTP paths of the media endpoints.
+const (
+	UploadPath = "/media/uploads/"
+	FilesPath  = "/media/files/"
+)
+
+// Defaults for Options limits left unset.
+const (
+	DefaultMaxUploadBytes = 10 << 20 // 10 MiB
+	DefaultMaxDimension   = 8192
+	DefaultUploadTTL      = 15 * time.Minute
+)
+
+// Options configure uploads.
+type Options struct {
+	// PublicURL is where clients reach this server's media endpoints, e.g.
+	// "http://localhost:50052".
+	PublicURL      string
+	MaxUploadBytes int64
+	MaxDimension   int // width and height limit in pixels
+	UploadTTL      time.Duration
+}
+
+// UploadURL is where the file for a reserved media id is PUT.
+func (o Options) UploadURL(mediaId int64) string {
+	return strings.TrimSuffix(o.PublicURL, "/") + UploadPath + strconv.FormatInt(mediaId, 10)
+}
+
+// FileURL is where a stored file is served from.
+func (o Options) FileURL(key string) string {
+	return strings.TrimSuffix(o.PublicURL, "/") + FilesPath + key
+}
+
+// FileStore keeps blobs as files in one directory.
+type FileStore struct {
+	dir string
+}
+
+var _ BlobStore = (*FileStore)(nil)
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media directory %s: %w", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// path maps a key to its file. Keys are generated by the server, but a key from a URL
+// must still not escape the directory.
+func (s *FileStore) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+// Put writes to a temporary file and renames it, so readers never see a partial blob.
+func (s *FileStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob %s: %w", key, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store blob %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *FileStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, ErrBlobNotFound
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob %s: %w", key, err)
+	}
+	return f, nil
+}
+
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob %s: %w", key, err)
+	}
+	return nil
+}
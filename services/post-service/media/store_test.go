@@ -0,0 +1,41 @@
+package media
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFileStorePath(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{"plain key", "123.png", false},
+		{"empty", "", true},
+		{"parent directory", "..", true},
+		{"current directory", ".", true},
+		{"traversal", "../etc/passwd", true},
+		{"nested", "a/b.png", true},
+		{"absolute", "/etc/passwd", true},
+		{"hidden temp file", ".upload-123", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.path(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("path(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if err == nil && filepath.Dir(got) != dir {
+				t.Errorf("path(%q) = %q, outside %q", tt.key, got, dir)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package ranking scores candidate posts for the recommended feed.
+package ranking
+
+import (
+	"math"
+	"time"
+
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+)
+
+// Source says where a candidate came from.
+type Source string
+
+const (
+	SourceFollowing    Source = "following"     // posted by an account the viewer follows
+	SourceSecondDegree Source = "second_degree" // posted by an account from the viewer's follow suggestions
+	SourceTrending     Source = "trending"      // posted under a currently trending hashtag
+)
+
+// Candidate is a post considered for the feed together with the features scorers use.
+type Candidate struct {
+	Post        *postsv1.Post
+	Source      Source
+	Engagements *postsv1.PostEngagements
+	// AuthorLikes is how many of the viewer's recent likes went to this post's author.
+	AuthorLikes int
+}
+
+// Scorer is a named scoring function. The name is logged with every served post so
+// rankings from different scorers can be compared offline.
+type Scorer struct {
+	Name  string
+	Score func(c Candidate, now time.Time) float64
+}
+
+const (
+	recencyHalfLife = 6 * time.Hour
+	affinityWeight  = 0.5
+)
+
+// sourceBoost slightly prefers posts from accounts the viewer chose to follow.
+var sourceBoost = map[Source]float64{
+	SourceFollowing:    1.2,
+	SourceSecondDegree: 1.0,
+	SourceTrending:     1.0,
+}
+
+// Default multiplies engagement velocity, author affinity and an exponential recency
+// decay, so a fresh post with little engagement can still beat an old popular one.
+var Default = Scorer{
+	Name: "velocity-affinity-decay-v1",
+	Score: func(c Candidate, now time.Time) float64 {
+		age := max(now.Sub(c.Post.CreatedAt.AsTime()), 0)
+		decay := math.Exp2(-age.Hours() / recencyHalfLife.Hours())
+		affinity := 1 + affinityWeight*math.Log1p(float64(c.AuthorLikes))
+
+		return sourceBoost[c.Source] * (1 + math.Log1p(Velocity(c.Engagements, age))) * affinity * decay
+	},
+}
+
+// Velocity is weighted engagement per hour since posting. Replies and reposts take more
+// effort than likes and count for more.
+func Velocity(e *postsv1.PostEngagements, age time.Duration) float64 {
+	weighted := float64(e.GetLikeCount()) + 2*float64(e.GetCommentCount()) + 3*float64(e.GetRepostCount())
+	return weighted / max(age.Hours(), 1)
+}
@@ -0,0 +1,81 @@
+package ranking
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVelocity(t *testing.T) {
+	tests := []struct {
+		name string
+		e    *postsv1.PostEngagements
+		age  time.Duration
+		want float64
+	}{
+		{"nil engagements", nil, time.Hour, 0},
+		{"weighted", &postsv1.PostEngagements{LikeCount: 1, CommentCount: 1, RepostCount: 1}, time.Hour, 6},
+		{"shares don't count", &postsv1.PostEngagements{ShareCount: 10}, time.Hour, 0},
+		{"per hour", &postsv1.PostEngagements{LikeCount: 12}, 4 * time.Hour, 3},
+		{"young posts count as an hour old", &postsv1.PostEngagements{LikeCount: 12}, time.Minute, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Velocity(tt.e, tt.age); got != tt.want {
+				t.Errorf("Velocity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultScorer(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	candidate := func(source Source, age time.Duration, likes int64, authorLikes int) Candidate {
+		return Candidate{
+			Post:        &postsv1.Post{CreatedAt: timestamppb.New(now.Add(-age))},
+			Source:      source,
+			Engagements: &postsv1.PostEngagements{LikeCount: likes},
+			AuthorLikes: authorLikes,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		higher, lower Candidate
+	}{
+		{"newer beats older", candidate(SourceTrending, time.Hour, 0, 0), candidate(SourceTrending, 12*time.Hour, 0, 0)},
+		{"more engagement beats less", candidate(SourceTrending, time.Hour, 50, 0), candidate(SourceTrending, time.Hour, 5, 0)},
+		{"liked author beats unknown", candidate(SourceTrending, time.Hour, 0, 3), candidate(SourceTrending, time.Hour, 0, 0)},
+		{"followed beats second degree", candidate(SourceFollowing, time.Hour, 0, 0), candidate(SourceSecondDegree, time.Hour, 0, 0)},
+		{"fresh quiet post beats old popular one", candidate(SourceTrending, 0, 2, 0), candidate(SourceTrending, 48*time.Hour, 500, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			higher, lower := Default.Score(tt.higher, now), Default.Score(tt.lower, now)
+			if higher <= lower {
+				t.Errorf("score %v should be above %v", higher, lower)
+			}
+		})
+	}
+
+	t.Run("half life", func(t *testing.T) {
+		fresh := Default.Score(candidate(SourceTrending, 0, 0, 0), now)
+		old := Default.Score(candidate(SourceTrending, recencyHalfLife, 0, 0), now)
+		if math.Abs(old-fresh/2) > 1e-9 {
+			t.Errorf("score after one half life = %v, want %v", old, fresh/2)
+		}
+	})
+
+	t.Run("future posts don't get a boost", func(t *testing.T) {
+		fresh := Default.Score(candidate(SourceTrending, 0, 0, 0), now)
+		future := Default.Score(candidate(SourceTrending, -time.Hour, 0, 0), now)
+		if future != fresh {
+			t.Errorf("future post score = %v, want %v", future, fresh)
+		}
+	})
+}
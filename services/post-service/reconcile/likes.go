@@ -0,0 +1,52 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yaninyzwitty/threads-go-backend/services/post-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
+)
+
+// LikedPostsReport summarises a liked_posts_by_user backfill.
+type LikedPostsReport struct {
+	RowsScanned int // likes_by_user rows read
+	Copied      int // rows written to liked_posts_by_user
+}
+
+// LikedPosts copies every likes_by_user row into liked_posts_by_user. It backfills likes
+// recorded before the like.created consumer started writing both tables and is safe to
+// run again.
+func LikedPosts(ctx context.Context, repo *repository.PostRepository, opts Options) (LikedPostsReport, error) {
+	var report LikedPostsReport
+
+	wait, stop := helpers.Throttle(opts.Rate)
+	defer stop()
+
+	var pageState []byte
+	for {
+		if err := wait(ctx); err != nil {
+			return report, err
+		}
+
+		likes, next, err := repo.ScanUserLikes(ctx, opts.PageSize, pageState)
+		if err != nil {
+			return report, fmt.Errorf("failed to scan likes_by_user: %w", err)
+		}
+		report.RowsScanned += len(likes)
+
+		if !opts.DryRun {
+			if err := repo.IndexLikedPosts(ctx, likes); err != nil {
+				return report, err
+			}
+			report.Copied += len(likes)
+		}
+
+		if len(next) == 0 {
+			break
+		}
+		pageState = next
+	}
+
+	return report, nil
+}
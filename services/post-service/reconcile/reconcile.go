@@ -0,0 +1,8 @@
+package reconcile
+
+// Options controls a reconciliation run.
+type Options struct {
+	DryRun   bool // report what would change without writing anything
+	PageSize int  // rows per Cassandra page
+	Rate     int  // max pages per second, 0 for unlimited
+}
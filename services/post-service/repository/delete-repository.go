@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gocql/gocql"
+	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// purgePageSize is how many likes or reposts of a deleted post are read per page.
+const purgePageSize = 500
+
+// DeletePost deletes the post row, records the deletion in deleted_posts and writes a
+// post.deleted outbox event in one logged batch. Consumers of the event remove the
+// post's denormalized copies.
+func (r *PostRepository) DeletePost(ctx context.Context, post *postv1.Post) error {
+	const (
+		deletePostQuery = `DELETE FROM threads_keyspace.posts WHERE post_id = ?`
+
+		insertTombstoneQuery = `INSERT INTO threads_keyspace.deleted_posts (post_id, user_id, deleted_at) VALUES (?, ?, ?)`
+
+		insertOutboxQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (uuid(), ?, ?, false) USING TTL 86400`
+
+		eventType = "post.deleted"
+	)
+
+	payload, err := protojson.Marshal(post)
+	if err != nil {
+		return fmt.Errorf("failed to marshal post for outbox: %w", err)
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(deletePostQuery, post.Id)
+	batch.Query(insertTombstoneQuery, post.Id, post.User.GetId(), time.Now())
+	batch.Query(insertOutboxQuery, eventType, payload)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to execute post deletion batch: %w", err)
+	}
+	return nil
+}
+
+// IsPostDeleted reports whether the post has a deleted_posts tombstone.
+func (r *PostRepository) IsPostDeleted(ctx context.Context, postId int64) (bool, error) {
+	query := `SELECT post_id FROM threads_keyspace.deleted_posts WHERE post_id = ?`
+
+	var id int64
+	if err := r.session.Query(query, postId).WithContext(ctx).Scan(&id); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check deletion of post %d: %w", postId, err)
+	}
+	return true, nil
+}
+
+// DeletePostCopies removes a deleted post's rows from the tables keyed by it or by the
+// users who interacted with it. Every step is a plain delete, so running it again after a
+// partial failure is safe. The reply index entry is handled by RemoveReplyFromParent.
+// The post_engagements row goes too. A deleted counter can't be written again, which is
+// what we want: post ids are never reused, and late engagement events stop at the
+// deleted_posts tombstone.
+func (r *PostRepository) DeletePostCopies(ctx context.Context, post *postv1.Post) error {
+	if err := r.deletePostLikes(ctx, post.Id); err != nil {
+		return err
+	}
+	if err := r.deletePostReposts(ctx, post.Id); err != nil {
+		return err
+	}
+	if err := r.RemovePostHashtags(ctx, post, post.Hashtags, time.Now()); err != nil {
+		return err
+	}
+
+	const (
+		deleteByUserQuery    = `DELETE FROM threads_keyspace.posts_by_user WHERE user_id = ? AND post_id = ?`
+		deleteRepliesQuery   = `DELETE FROM threads_keyspace.replies_by_post WHERE post_id = ?`
+		deleteRevisionsQuery = `DELETE FROM threads_keyspace.post_revisions WHERE post_id = ?`
+
+		// Counter tables can't share a batch with regular tables.
+		deleteEngagementsQuery = `DELETE FROM threads_keyspace.post_engagements WHERE post_id = ?`
+	)
+
+	// Replies to the post stay up as posts of their own; only the index under the
+	// deleted parent goes.
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(deleteByUserQuery, post.User.GetId(), post.Id)
+	batch.Query(deleteRepliesQuery, post.Id)
+	batch.Query(deleteRevisionsQuery, post.Id)
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to delete copies of post %d: %w", post.Id, err)
+	}
+
+	if err := r.session.Query(deleteEngagementsQuery, post.Id).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to delete engagements of post %d: %w", post.Id, err)
+	}
+
+	r.InvalidateRecentPostIDs(ctx, post.User.GetId())
+	return nil
+}
+
+// deletePostLikes removes each liker's likes_by_user and liked_posts_by_user rows for
+// the post, then the like itself from likes_by_post. likes_by_post is only written
+// through lightweight transactions, so its rows are deleted one by one with IF EXISTS
+// rather than with a partition delete.
+func (r *PostRepository) deletePostLikes(ctx context.Context, postId int64) error {
+	const (
+		listQuery = `SELECT user_id, liked_at FROM threads_keyspace.likes_by_post WHERE post_id = ?`
+
+		deleteByUserQuery     = `DELETE FROM threads_keyspace.likes_by_user WHERE user_id = ? AND post_id = ?`
+		deleteLikedPostsQuery = `DELETE FROM threads_keyspace.liked_posts_by_user WHERE user_id = ? AND liked_at = ? AND post_id = ?`
+		deleteLikeQuery       = `DELETE FROM threads_keyspace.likes_by_post WHERE post_id = ? AND user_id = ? IF EXISTS`
+	)
+
+	var pageState []byte
+	for {
+		iter := r.session.Query(listQuery, postId).
+			WithContext(ctx).
+			PageSize(purgePageSize).
+			PageState(pageState).
+			Iter()
+
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(timelineWriteLimit)
+
+		var (
+			userId  int64
+			likedAt time.Time
+		)
+		for iter.Scan(&userId, &likedAt) {
+			userId, likedAt := userId, likedAt
+			g.Go(func() error {
+				batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(gctx)
+				batch.Query(deleteByUserQuery, userId, postId)
+				batch.Query(deleteLikedPostsQuery, userId, likedAt, postId)
+				if err := r.session.ExecuteBatch(batch); err != nil {
+					return fmt.Errorf("failed to delete like of post %d by user %d: %w", postId, userId, err)
+				}
+				if _, err := r.session.Query(deleteLikeQuery, postId, userId).WithContext(gctx).MapScanCAS(map[string]interface{}{}); err != nil {
+					return fmt.Errorf("failed to delete like of post %d by user %d: %w", postId, userId, err)
+				}
+				return nil
+			})
+		}
+
+		pageState = iter.PageState()
+
+		iterErr := iter.Close()
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		if iterErr != nil {
+			return fmt.Errorf("failed to list likes of post %d: %w", postId, iterErr)
+		}
+
+		if len(pageState) == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// deletePostReposts removes the repost entries other users have for the post from their
+// posts_by_user partitions, then the post's reposts_by_post partition.
+func (r *PostRepository) deletePostReposts(ctx context.Context, postId int64) error {
+	const (
+		listQuery = `SELECT user_id, repost_id FROM threads_keyspace.reposts_by_post WHERE post_id = ?`
+
+		deleteEntryQuery     = `DELETE FROM threads_keyspace.posts_by_user WHERE user_id = ? AND post_id = ?`
+		deletePartitionQuery = `DELETE FROM threads_keyspace.reposts_by_post WHERE post_id = ?`
+	)
+
+	var pageState []byte
+	for {
+		iter := r.session.Query(listQuery, postId).
+			WithContext(ctx).
+			PageSize(purgePageSize).
+			PageState(pageState).
+			Iter()
+
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(timelineWriteLimit)
+
+		var userId, repostId int64
+		for iter.Scan(&userId, &repostId) {
+			userId, repostId := userId, repostId
+			g.Go(func() error {
+				if err := r.session.Query(deleteEntryQuery, userId, repostId).WithContext(gctx).Exec(); err != nil {
+					return fmt.Errorf("failed to delete repost %d of post %d: %w", repostId, postId, err)
+				}
+				return nil
+			})
+		}
+
+		pageState = iter.PageState()
+
+		iterErr := iter.Close()
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		if iterErr != nil {
+			return fmt.Errorf("failed to list reposts of post %d: %w", postId, iterErr)
+		}
+
+		if len(pageState) == 0 {
+			break
+		}
+	}
+
+	if err := r.session.Query(deletePartitionQuery, postId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to delete reposts of post %d: %w", postId, err)
+	}
+	return nil
+}
+
+// RemoveReplyFromParent takes a deleted reply out of its parent's replies_by_post
+// partition and decrements the parent's comment_count. The delete is a lightweight
+// transaction, so only the call that removed the row touches the counter; it reports
+// whether this call did the work.
+func (r *PostRepository) RemoveReplyFromParent(ctx context.Context, reply *postv1.Post) (bool, error) {
+	const (
+		deleteReplyQuery = `DELETE FROM threads_keyspace.replies_by_post WHERE post_id = ? AND reply_id = ? IF EXISTS`
+
+		restoreReplyQuery = `
+			INSERT INTO threads_keyspace.replies_by_post
+			(post_id, reply_id, user_id, content, image_url, created_at, audience, root_post_id, quote_post_id, mentions, media)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	)
+
+	applied, err := r.session.Query(deleteReplyQuery, reply.ReplyToPostId, reply.Id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to remove reply %d from post %d: %w", reply.Id, reply.ReplyToPostId, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	if err := r.SafeDecrementEngagementCounts(ctx, reply.ReplyToPostId, "comment_count"); err != nil {
+		// Put the row back so the retried event decrements the count.
+		if restoreErr := r.session.Query(restoreReplyQuery,
+			reply.ReplyToPostId,
+			reply.Id,
+			reply.User.GetId(),
+			reply.Content,
+			reply.ImageUrl,
+			reply.CreatedAt.AsTime(),
+			int32(reply.Audience),
+			reply.RootPostId,
+			reply.QuotePostId,
+			mentionsOf(reply),
+			mediaOf(reply),
+		).WithContext(ctx).Exec(); restoreErr != nil {
+			slog.Error("failed to restore reply index", "post_id", reply.ReplyToPostId, "reply_id", reply.Id, "error", restoreErr)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gocql/gocql"
+	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrEditConflict is returned when the post changed (or was deleted) after the caller read it.
+var ErrEditConflict = errors.New("post was modified concurrently")
+
+// EditPost replaces the post's content with edited's and records previous, the version
+// the caller read, in post_revisions. The update is a lightweight transaction on
+// edited_at so two concurrent edits cannot both claim the same previous version. The
+// revision and the post.edited outbox event are written afterwards; if that fails the
+// post is put back.
+func (r *PostRepository) EditPost(ctx context.Context, edited, previous *postv1.Post) error {
+	const (
+		updatePostQuery = `
+			UPDATE threads_keyspace.posts
+			SET content = ?, image_url = ?, hashtags = ?, mentions = ?, edited_at = ?
+			WHERE post_id = ?
+			IF edited_at = ?`
+
+		insertRevisionQuery = `
+			INSERT INTO threads_keyspace.post_revisions (post_id, replaced_at, content, image_url, created_at)
+			VALUES (?, ?, ?, ?, ?)`
+
+		insertOutboxQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (uuid(), ?, ?, false) USING TTL 86400`
+
+		eventType = "post.edited"
+	)
+
+	payload, err := protojson.Marshal(edited)
+	if err != nil {
+		return fmt.Errorf("failed to marshal post for outbox: %w", err)
+	}
+
+	editedAt := edited.EditedAt.AsTime()
+	previousEditedAt := editedAtValue(previous.EditedAt)
+
+	applied, err := r.session.Query(updatePostQuery,
+		edited.Content,
+		edited.ImageUrl,
+		edited.Hashtags,
+		mentionsOf(edited),
+		editedAt,
+		edited.Id,
+		previousEditedAt,
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to update post %d: %w", edited.Id, err)
+	}
+	if !applied {
+		return ErrEditConflict
+	}
+
+	// The replaced version went live when the post was created or last edited.
+	publishedAt := previous.CreatedAt.AsTime()
+	if previous.EditedAt != nil {
+		publishedAt = previous.EditedAt.AsTime()
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(insertRevisionQuery, edited.Id, editedAt, previous.Content, previous.ImageUrl, publishedAt)
+	batch.Query(insertOutboxQuery, eventType, payload)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		// A logged batch that timed out after reaching the batch log will still be
+		// applied, so restoring could leave a revision and event for an edit that was
+		// undone. Keep the edit.
+		if IsBatchWriteTimeout(err) {
+			slog.Warn("post edit batch timed out; treating it as applied", "post_id", edited.Id, "error", err)
+			return nil
+		}
+
+		// Put the previous version back so the post and its history stay consistent.
+		if _, restoreErr := r.session.Query(updatePostQuery,
+			previous.Content,
+			previous.ImageUrl,
+			previous.Hashtags,
+			mentionsOf(previous),
+			previousEditedAt,
+			edited.Id,
+			editedAt,
+		).WithContext(ctx).MapScanCAS(map[string]interface{}{}); restoreErr != nil {
+			slog.Error("failed to restore post after failed edit", "post_id", edited.Id, "error", restoreErr)
+		}
+		return fmt.Errorf("failed to execute post edit batch: %w", err)
+	}
+
+	return nil
+}
+
+// IsBatchWriteTimeout reports whether err is a coordinator's write timeout for a logged
+// batch. The batch is in the batch log by then and will be applied. Any other error,
+// including a client side timeout, is treated as not applied.
+func IsBatchWriteTimeout(err error) bool {
+	var writeTimeout *gocql.RequestErrWriteTimeout
+	return errors.As(err, &writeTimeout) && writeTimeout.WriteType == "BATCH"
+}
+
+// editedAtValue binds an optional edited_at; nil compares equal to a null column.
+func editedAtValue(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime()
+}
+
+// UpdatePostIndexes copies an edit into the post's posts_by_user row and, for replies,
+// its replies_by_post row. Writes are timestamped with the edit time, so a redelivered
+// older edit never overwrites a newer one. Rows not written yet are skipped rather than
+// created partially, which would also stop CreateReplyIndexedByPost's IF NOT EXISTS
+// insert; the create path applies the edit once the row exists.
+func (r *PostRepository) UpdatePostIndexes(ctx context.Context, post *postv1.Post) error {
+	const (
+		selectByUserQuery = `SELECT post_id FROM threads_keyspace.posts_by_user WHERE user_id = ? AND post_id = ?`
+
+		updateByUserQuery = `
+			UPDATE threads_keyspace.posts_by_user
+			SET content = ?, image_url = ?, mentions = ?, edited_at = ?
+			WHERE user_id = ? AND post_id = ?`
+
+		selectReplyQuery = `SELECT reply_id FROM threads_keyspace.replies_by_post WHERE post_id = ? AND reply_id = ?`
+
+		updateReplyQuery = `
+			UPDATE threads_keyspace.replies_by_post
+			SET content = ?, image_url = ?, mentions = ?, edited_at = ?
+			WHERE post_id = ? AND reply_id = ?`
+	)
+
+	editedAt := post.EditedAt.AsTime()
+	mentions := mentionsOf(post)
+
+	update := func(selectQuery, updateQuery string, partitionId int64) error {
+		var id int64
+		if err := r.session.Query(selectQuery, partitionId, post.Id).WithContext(ctx).Scan(&id); err != nil {
+			if err == gocql.ErrNotFound {
+				return nil
+			}
+			return err
+		}
+
+		return r.session.Query(updateQuery, post.Content, post.ImageUrl, mentions, editedAt, partitionId, post.Id).
+			WithContext(ctx).
+			WithTimestamp(editedAt.UnixMicro()).
+			Exec()
+	}
+
+	if err := update(selectByUserQuery, updateByUserQuery, post.User.GetId()); err != nil {
+		return fmt.Errorf("failed to update posts_by_user for post %d: %w", post.Id, err)
+	}
+	if post.ReplyToPostId != 0 {
+		if err := update(selectReplyQuery, updateReplyQuery, post.ReplyToPostId); err != nil {
+			return fmt.Errorf("failed to update replies_by_post for post %d: %w", post.Id, err)
+		}
+	}
+	return nil
+}
+
+// ListPostRevisions pages through a post's replaced versions, newest first.
+func (r *PostRepository) ListPostRevisions(ctx context.Context, postId int64, pageSize int32, pagingState []byte) ([]*postv1.PostRevision, []byte, error) {
+	query := `
+		SELECT replaced_at, content, image_url, created_at
+		FROM threads_keyspace.post_revisions
+		WHERE post_id = ?`
+
+	iter := r.session.Query(query, postId).
+		WithContext(ctx).
+		PageSize(int(pageSize)).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		revisions  []*postv1.PostRevision
+		replacedAt time.Time
+		content    string
+		imageURL   string
+		createdAt  time.Time
+	)
+
+	for iter.Scan(&replacedAt, &content, &imageURL, &createdAt) {
+		revisions = append(revisions, &postv1.PostRevision{
+			PostId:     postId,
+			Content:    content,
+			ImageUrl:   imageURL,
+			CreatedAt:  timestamppb.New(createdAt),
+			ReplacedAt: timestamppb.New(replacedAt),
+		})
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list revisions of post %d: %w", postId, err)
+	}
+
+	return revisions, nextPageState, nil
+}
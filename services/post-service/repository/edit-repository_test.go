@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gocql/gocql"
+)
+
+func TestIsBatchWriteTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"batch write timeout", &gocql.RequestErrWriteTimeout{WriteType: "BATCH"}, true},
+		{"wrapped batch write timeout", fmt.Errorf("edit: %w", &gocql.RequestErrWriteTimeout{WriteType: "BATCH"}), true},
+		{"batch log write timeout", &gocql.RequestErrWriteTimeout{WriteType: "BATCH_LOG"}, false},
+		{"simple write timeout", &gocql.RequestErrWriteTimeout{WriteType: "SIMPLE"}, false},
+		{"client timeout", gocql.ErrTimeoutNoResponse, false},
+		{"deadline exceeded", context.DeadlineExceeded, false},
+		{"other error", errors.New("unavailable"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBatchWriteTimeout(tt.err); got != tt.want {
+				t.Errorf("IsBatchWriteTimeout(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/gocql/gocql"
+	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"github.com/yaninyzwitty/threads-go-backend/shared/database"
+)
+
+// FeedImpression is one post served by the recommended feed.
+type FeedImpression struct {
+	PostID   int64
+	Position int
+	Source   string
+	Score    float64
+}
+
+// SelectEngagementCountsByIDs reads engagement counters for several posts. Posts with no
+// row are left out.
+func (r *PostRepository) SelectEngagementCountsByIDs(ctx context.Context, postIds []int64) (map[int64]*postv1.PostEngagements, error) {
+	query := `
+		SELECT post_id, like_count, share_count, comment_count, repost_count
+		FROM threads_keyspace.post_engagements
+		WHERE post_id IN ?`
+
+	engagements := make(map[int64]*postv1.PostEngagements, len(postIds))
+	for chunk := range database.ChunkIDs(postIds) {
+		iter := r.session.Query(query, chunk).WithContext(ctx).Iter()
+
+		var (
+			postID int64
+			e      postv1.PostEngagements
+		)
+		for iter.Scan(&postID, &e.LikeCount, &e.ShareCount, &e.CommentCount, &e.RepostCount) {
+			engagements[postID] = &postv1.PostEngagements{
+				LikeCount:    e.LikeCount,
+				ShareCount:   e.ShareCount,
+				CommentCount: e.CommentCount,
+				RepostCount:  e.RepostCount,
+			}
+		}
+
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to select engagement counts: %w", err)
+		}
+	}
+
+	return engagements, nil
+}
+
+// ListSuggestedUserIDs returns the user's top follow suggestions, computed by the
+// processor-service from the user's second-degree network.
+func (r *PostRepository) ListSuggestedUserIDs(ctx context.Context, userId int64, limit int) ([]int64, error) {
+	query := `SELECT suggested_id FROM threads_keyspace.follow_suggestions_by_user WHERE user_id = ? LIMIT ?`
+
+	iter := r.session.Query(query, userId, limit).WithContext(ctx).Iter()
+
+	var (
+		ids []int64
+		id  int64
+	)
+	for iter.Scan(&id) {
+		ids = append(ids, id)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list follow suggestions for user %d: %w", userId, err)
+	}
+	return ids, nil
+}
+
+func likedAuthorsCacheKey(userId int64) string {
+	return fmt.Sprintf("user:%d:feed_liked_authors", userId)
+}
+
+// GetCachedLikedAuthors returns the cached author id of each of the user's recent likes,
+// one entry per like.
+func (r *PostRepository) GetCachedLikedAuthors(ctx context.Context, userId int64) (ids []int64, ok bool) {
+	return r.getCachedIDs(ctx, likedAuthorsCacheKey(userId))
+}
+
+func (r *PostRepository) CacheLikedAuthors(ctx context.Context, userId int64, ids []int64, ttl time.Duration) {
+	r.setCachedIDs(ctx, likedAuthorsCacheKey(userId), ids, ttl)
+}
+
+func feedSeenCacheKey(viewerId int64, sessionId string) string {
+	return fmt.Sprintf("feed:%d:%s:seen", viewerId, sessionId)
+}
+
+// GetSeenPostIDs returns the posts already served in a feed session. Cache errors are
+// logged and treated as nothing seen.
+func (r *PostRepository) GetSeenPostIDs(ctx context.Context, viewerId int64, sessionId string) map[int64]bool {
+	if r.cache == nil {
+		return nil
+	}
+	members, err := r.cache.SMembers(ctx, feedSeenCacheKey(viewerId, sessionId)).Result()
+	if err != nil {
+		slog.Warn("feed session read failed", "viewer_id", viewerId, "error", err)
+		return nil
+	}
+
+	seen := make(map[int64]bool, len(members))
+	for _, member := range members {
+		if id, err := strconv.ParseInt(member, 10, 64); err == nil {
+			seen[id] = true
+		}
+	}
+	return seen
+}
+
+// AddSeenPostIDs records served posts in the feed session and extends its expiry.
+func (r *PostRepository) AddSeenPostIDs(ctx context.Context, viewerId int64, sessionId string, postIds []int64, ttl time.Duration) {
+	if len(postIds) == 0 || r.cache == nil {
+		return
+	}
+
+	key := feedSeenCacheKey(viewerId, sessionId)
+	members := make([]interface{}, len(postIds))
+	for i, id := range postIds {
+		members[i] = strconv.FormatInt(id, 10)
+	}
+
+	pipe := r.cache.TxPipeline()
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.Warn("feed session write failed", "viewer_id", viewerId, "error", err)
+	}
+}
+
+// LogFeedImpressions records what the recommended feed served, with the scorer that
+// ranked it, for offline evaluation. The rows share the viewer's partition.
+func (r *PostRepository) LogFeedImpressions(ctx context.Context, viewerId int64, sessionId, scorer string, impressions []FeedImpression) error {
+	query := `
+		INSERT INTO threads_keyspace.feed_impressions
+		(viewer_id, served_at, position, session_id, post_id, source, score, scorer)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	if len(impressions) == 0 {
+		return nil
+	}
+
+	servedAt := gocql.TimeUUID()
+
+	batch := r.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, imp := range impressions {
+		batch.Query(query, viewerId, servedAt, imp.Position, sessionId, imp.PostID, imp.Source, imp.Score, scorer)
+	}
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to log feed impressions: %w", err)
+	}
+	return nil
+}
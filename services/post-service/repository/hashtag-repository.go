@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/redis/go-redis/v9"
+	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/shared/entities"
+	"github.com/yaninyzwitty/threads-go-backend/shared/trending"
+)
+
+// setEntities parses the post's content into Entities. Mentions are kept only when
+// their handle is in mentions, the resolved handles stored with the row; the rest stay
+// plain text. Rows without stored hashtags (denormalized copies, or posts from before
+// hashtags were stored) get them from the same parse.
+func setEntities(post *postv1.Post, mentions map[string]int64) {
+	parsed := entities.Parse(post.Content)
+	if post.Hashtags == nil {
+		post.Hashtags = entities.Values(parsed, entities.Hashtag)
+	}
+
+	post.Entities = post.Entities[:0]
+	post.MentionedUserIds = post.MentionedUserIds[:0]
+	for _, entity := range entities.ToProto(parsed) {
+		if entity.Type == userv1.TextEntity_TYPE_MENTION {
+			id, ok := mentions[strings.ToLower(entity.Value)]
+			if !ok {
+				continue
+			}
+			entity.UserId = id
+			if !slices.Contains(post.MentionedUserIds, id) {
+				post.MentionedUserIds = append(post.MentionedUserIds, id)
+			}
+		}
+		post.Entities = append(post.Entities, entity)
+	}
+}
+
+// mentionsOf returns the resolved mentions of a post to store with it, keyed by the
+// lower-cased handle.
+func mentionsOf(post *postv1.Post) map[string]int64 {
+	mentions := make(map[string]int64)
+	for _, entity := range post.Entities {
+		if entity.Type == userv1.TextEntity_TYPE_MENTION && entity.UserId != 0 {
+			mentions[strings.ToLower(entity.Value)] = entity.UserId
+		}
+	}
+	return mentions
+}
+
+// HashtagBucket is the posts_by_hashtag partition bucket for a post created at t: hours
+// since the Unix epoch.
+func HashtagBucket(t time.Time) int64 {
+	return t.Unix() / int64(time.Hour/time.Second)
+}
+
+// AddPostHashtags writes the post under each tag in posts_by_hashtag and marks the tag's
+// bucket as used, timestamped at. Rows are upserts, so repeating it is harmless.
+func (r *PostRepository) AddPostHashtags(ctx context.Context, post *postv1.Post, tags []string, at time.Time) error {
+	const (
+		insertPostQuery   = `INSERT INTO threads_keyspace.posts_by_hashtag (tag, bucket, post_id, user_id) VALUES (?, ?, ?, ?)`
+		insertBucketQuery = `INSERT INTO threads_keyspace.hashtag_buckets (tag, bucket) VALUES (?, ?)`
+	)
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	bucket := HashtagBucket(post.CreatedAt.AsTime())
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.WithTimestamp(at.UnixMicro())
+	for _, tag := range tags {
+		batch.Query(insertPostQuery, tag, bucket, post.Id, post.User.GetId())
+		batch.Query(insertBucketQuery, tag, bucket)
+	}
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to index hashtags of post %d: %w", post.Id, err)
+	}
+	return nil
+}
+
+// RemovePostHashtags deletes the post's posts_by_hashtag rows for the given tags,
+// timestamped at. Bucket rows are left in place; an empty bucket only costs readers one
+// extra query.
+func (r *PostRepository) RemovePostHashtags(ctx context.Context, post *postv1.Post, tags []string, at time.Time) error {
+	query := `DELETE FROM threads_keyspace.posts_by_hashtag WHERE tag = ? AND bucket = ? AND post_id = ?`
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	bucket := HashtagBucket(post.CreatedAt.AsTime())
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.WithTimestamp(at.UnixMicro())
+	for _, tag := range tags {
+		batch.Query(query, tag, bucket, post.Id)
+	}
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to remove hashtags of post %d: %w", post.Id, err)
+	}
+	return nil
+}
+
+// HashtagCursor is a position in a tag's posts: the bucket to read next and, within
+// it, the post id to continue below (0 for the whole bucket).
+type HashtagCursor struct {
+	Bucket   int64
+	BeforeID int64
+}
+
+// ListHashtagPostIDs returns up to limit post ids tagged with tag, newest first,
+// starting at from (the zero cursor starts at the newest post). At most maxBuckets
+// non-empty buckets are read. next is where the following call should start; more is
+// false when the tag has no older posts.
+func (r *PostRepository) ListHashtagPostIDs(ctx context.Context, tag string, from HashtagCursor, limit, maxBuckets int) (ids []int64, next HashtagCursor, more bool, err error) {
+	const (
+		bucketsQuery     = `SELECT bucket FROM threads_keyspace.hashtag_buckets WHERE tag = ? LIMIT ?`
+		bucketsFromQuery = `SELECT bucket FROM threads_keyspace.hashtag_buckets WHERE tag = ? AND bucket <= ? LIMIT ?`
+
+		postsQuery       = `SELECT post_id FROM threads_keyspace.posts_by_hashtag WHERE tag = ? AND bucket = ? LIMIT ?`
+		postsBeforeQuery = `SELECT post_id FROM threads_keyspace.posts_by_hashtag WHERE tag = ? AND bucket = ? AND post_id < ? LIMIT ?`
+	)
+
+	// One extra bucket tells us whether there is anything past the last one read.
+	var bucketIter *gocql.Iter
+	if from.Bucket == 0 {
+		bucketIter = r.session.Query(bucketsQuery, tag, maxBuckets+1).WithContext(ctx).Iter()
+	} else {
+		bucketIter = r.session.Query(bucketsFromQuery, tag, from.Bucket, maxBuckets+1).WithContext(ctx).Iter()
+	}
+
+	var (
+		buckets []int64
+		bucket  int64
+	)
+	for bucketIter.Scan(&bucket) {
+		buckets = append(buckets, bucket)
+	}
+	if err := bucketIter.Close(); err != nil {
+		return nil, HashtagCursor{}, false, fmt.Errorf("failed to list buckets of tag %q: %w", tag, err)
+	}
+
+	for i, bucket := range buckets {
+		if i == maxBuckets {
+			return ids, HashtagCursor{Bucket: bucket}, true, nil
+		}
+
+		var iter *gocql.Iter
+		if bucket == from.Bucket && from.BeforeID != 0 {
+			iter = r.session.Query(postsBeforeQuery, tag, bucket, from.BeforeID, limit-len(ids)).WithContext(ctx).Iter()
+		} else {
+			iter = r.session.Query(postsQuery, tag, bucket, limit-len(ids)).WithContext(ctx).Iter()
+		}
+
+		var postId int64
+		for iter.Scan(&postId) {
+			ids = append(ids, postId)
+		}
+		if err := iter.Close(); err != nil {
+			return nil, HashtagCursor{}, false, fmt.Errorf("failed to list posts of tag %q: %w", tag, err)
+		}
+
+		if len(ids) == limit {
+			return ids, HashtagCursor{Bucket: bucket, BeforeID: ids[len(ids)-1]}, true, nil
+		}
+	}
+
+	return ids, HashtagCursor{}, false, nil
+}
+
+// GetRevisionContent returns the content a post had before the edit made at replacedAt.
+func (r *PostRepository) GetRevisionContent(ctx context.Context, postId int64, replacedAt time.Time) (string, error) {
+	query := `SELECT content FROM threads_keyspace.post_revisions WHERE post_id = ? AND replaced_at = ?`
+
+	var content string
+	if err := r.session.Query(query, postId, replacedAt).WithContext(ctx).Scan(&content); err != nil {
+		return "", fmt.Errorf("failed to get revision of post %d at %s: %w", postId, replacedAt, err)
+	}
+	return content, nil
+}
+
+// ListTrendingHashtags returns up to limit trending tags, highest score first. Scores are
+// maintained in Redis by the processor-service; without Redis nothing is trending.
+func (r *PostRepository) ListTrendingHashtags(ctx context.Context, limit int) ([]*postv1.TrendingHashtag, error) {
+	if r.cache == nil {
+		return nil, nil
+	}
+	members, err := r.cache.ZRevRangeWithScores(ctx, trending.HashtagsKey, 0, int64(limit-1)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to list trending hashtags: %w", err)
+	}
+
+	hashtags := make([]*postv1.TrendingHashtag, 0, len(members))
+	for _, member := range members {
+		tag, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		hashtags = append(hashtags, &postv1.TrendingHashtag{Tag: tag, Score: member.Score})
+	}
+	return hashtags, nil
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+)
+
+// ErrMediaNotFound is returned when a media id has no media_uploads row, either because
+// it was never reserved or because the upload window expired.
+var ErrMediaNotFound = errors.New("media not found")
+
+// Upload statuses.
+const (
+	MediaPending  = "pending"
+	MediaReady    = "ready"
+	MediaAttached = "attached" // used by a post; can't be attached again
+)
+
+// MediaUpload is a media_uploads row.
+type MediaUpload struct {
+	ID          int64
+	OwnerID     int64
+	ContentType string
+	MaxBytes    int64
+	TokenHash   string
+	Status      string
+	StorageKey  string
+	URL         string
+	Width       int32
+	Height      int32
+	SizeBytes   int64
+	CreatedAt   time.Time
+}
+
+// postMedia is the post_media type stored in the media column of post tables.
+type postMedia struct {
+	MediaID     int64  `cql:"media_id"`
+	MediaType   int32  `cql:"media_type"`
+	ContentType string `cql:"content_type"`
+	URL         string `cql:"url"`
+	Width       int32  `cql:"width"`
+	Height      int32  `cql:"height"`
+	AltText     string `cql:"alt_text"`
+}
+
+// mediaOf returns a post's attachments to store with it.
+func mediaOf(post *postv1.Post) []postMedia {
+	rows := make([]postMedia, len(post.Media))
+	for i, m := range post.Media {
+		rows[i] = postMedia{
+			MediaID:     m.Id,
+			MediaType:   int32(m.Type),
+			ContentType: m.ContentType,
+			URL:         m.Url,
+			Width:       m.Width,
+			Height:      m.Height,
+			AltText:     m.AltText,
+		}
+	}
+	return rows
+}
+
+// setMedia fills in a post's attachments from stored rows.
+func setMedia(post *postv1.Post, rows []postMedia) {
+	post.Media = nil
+	for _, row := range rows {
+		post.Media = append(post.Media, &postv1.Media{
+			Id:          row.MediaID,
+			Type:        postv1.MediaType(row.MediaType),
+			ContentType: row.ContentType,
+			Url:         row.URL,
+			Width:       row.Width,
+			Height:      row.Height,
+			AltText:     row.AltText,
+		})
+	}
+}
+
+// CreateMediaUpload reserves a media id. The row expires after ttl unless the upload
+// finishes first.
+func (r *PostRepository) CreateMediaUpload(ctx context.Context, upload *MediaUpload, ttl time.Duration) error {
+	query := `
+		INSERT INTO threads_keyspace.media_uploads
+		(media_id, owner_id, content_type, max_bytes, token_hash, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		USING TTL ?`
+
+	if err := r.session.Query(query,
+		upload.ID,
+		upload.OwnerID,
+		upload.ContentType,
+		upload.MaxBytes,
+		upload.TokenHash,
+		MediaPending,
+		upload.CreatedAt,
+		int(ttl/time.Second),
+	).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to create upload %d: %w", upload.ID, err)
+	}
+	return nil
+}
+
+const mediaUploadColumns = `media_id, owner_id, content_type, max_bytes, token_hash, status, storage_key, url, width, height, size_bytes, created_at`
+
+func scanMediaUpload(scan func(dest ...interface{}) error) (*MediaUpload, error) {
+	var upload MediaUpload
+	if err := scan(&upload.ID, &upload.OwnerID, &upload.ContentType, &upload.MaxBytes, &upload.TokenHash, &upload.Status,
+		&upload.StorageKey, &upload.URL, &upload.Width, &upload.Height, &upload.SizeBytes, &upload.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// GetMediaUpload returns ErrMediaNotFound when the id is unknown or expired.
+func (r *PostRepository) GetMediaUpload(ctx context.Context, mediaId int64) (*MediaUpload, error) {
+	query := `SELECT ` + mediaUploadColumns + ` FROM threads_keyspace.media_uploads WHERE media_id = ?`
+
+	upload, err := scanMediaUpload(r.session.Query(query, mediaId).WithContext(ctx).Scan)
+	if err == gocql.ErrNotFound {
+		return nil, ErrMediaNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload %d: %w", mediaId, err)
+	}
+	return upload, nil
+}
+
+// GetMediaUploads returns the uploads found among mediaIds, keyed by id.
+func (r *PostRepository) GetMediaUploads(ctx context.Context, mediaIds []int64) (map[int64]*MediaUpload, error) {
+	query := `SELECT ` + mediaUploadColumns + ` FROM threads_keyspace.media_uploads WHERE media_id IN ?`
+
+	uploads := make(map[int64]*MediaUpload, len(mediaIds))
+	if len(mediaIds) == 0 {
+		return uploads, nil
+	}
+
+	scanner := r.session.Query(query, mediaIds).WithContext(ctx).Iter().Scanner()
+	for scanner.Next() {
+		upload, err := scanMediaUpload(scanner.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan upload: %w", err)
+		}
+		uploads[upload.ID] = upload
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get uploads: %w", err)
+	}
+	return uploads, nil
+}
+
+// CompleteMediaUpload marks a pending upload ready with the stored file's details. Every
+// column is rewritten without a TTL so the row outlives the upload window. The update
+// is a lightweight transaction on the status; it reports false if the upload was
+// already completed.
+func (r *PostRepository) CompleteMediaUpload(ctx context.Context, upload *MediaUpload) (bool, error) {
+	query := `
+		UPDATE threads_keyspace.media_uploads USING TTL 0
+		SET owner_id = ?, content_type = ?, max_bytes = ?, token_hash = ?, status = ?,
+			storage_key = ?, url = ?, width = ?, height = ?, size_bytes = ?, created_at = ?
+		WHERE media_id = ?
+		IF status = ?`
+
+	applied, err := r.session.Query(query,
+		upload.OwnerID,
+		upload.ContentType,
+		upload.MaxBytes,
+		upload.TokenHash,
+		MediaReady,
+		upload.StorageKey,
+		upload.URL,
+		upload.Width,
+		upload.Height,
+		upload.SizeBytes,
+		upload.CreatedAt,
+		upload.ID,
+		MediaPending,
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to complete upload %d: %w", upload.ID, err)
+	}
+	return applied, nil
+}
+
+// AttachMediaUpload marks a ready upload as used by a post. The update is a lightweight
+// transaction on the status, so each upload goes on one post; it reports false if the
+// upload was not ready.
+func (r *PostRepository) AttachMediaUpload(ctx context.Context, mediaId int64) (bool, error) {
+	query := `UPDATE threads_keyspace.media_uploads SET status = ? WHERE media_id = ? IF status = ?`
+
+	applied, err := r.session.Query(query, MediaAttached, mediaId, MediaReady).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to attach upload %d: %w", mediaId, err)
+	}
+	return applied, nil
+}
+
+// ReleaseMediaUpload makes an upload attached to a post that was never written ready
+// again.
+func (r *PostRepository) ReleaseMediaUpload(ctx context.Context, mediaId int64) error {
+	query := `UPDATE threads_keyspace.media_uploads SET status = ? WHERE media_id = ? IF status = ?`
+
+	if _, err := r.session.Query(query, MediaReady, mediaId, MediaAttached).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+		return fmt.Errorf("failed to release upload %d: %w", mediaId, err)
+	}
+	return nil
+}
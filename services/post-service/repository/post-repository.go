@@ -2,33 +2,46 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/redis/go-redis/v9"
 	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
 	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/shared/database"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ErrPostNotFound is returned when a post id has no row in the posts table.
+var ErrPostNotFound = errors.New("post not found")
+
 type PostRepository struct {
 	session *gocql.Session
+	cache   *redis.Client
 }
 
-func NewPostRepository(session *gocql.Session) *PostRepository {
-	return &PostRepository{session: session}
+// NewPostRepository returns a repository over session. cache is optional: when nil the
+// timeline and feed session caches are skipped and no hashtags are trending.
+func NewPostRepository(session *gocql.Session, cache *redis.Client) *PostRepository {
+	return &PostRepository{session: session, cache: cache}
 }
 
-func (r *PostRepository) CreatePost(ctx context.Context, post *postv1.Post) error {
+// CreatePost writes the post and its outbox events in one logged batch. Each user in
+// notify gets a post.mentioned event.
+func (r *PostRepository) CreatePost(ctx context.Context, post *postv1.Post, notify []int64) error {
 
 	const (
-		insertPostQuery = `INSERT INTO threads_keyspace.posts (post_id, user_id, content, image_url, created_at) VALUES (?, ?, ?, ?, ?)`
+		insertPostQuery = `INSERT INTO threads_keyspace.posts (post_id, user_id, content, image_url, created_at, audience, reply_to_post_id, root_post_id, quote_post_id, hashtags, mentions, media) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 		insertOutboxQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (uuid(), ?, ?, false) USING TTL 86400`
 
-		eventType = "post.created"
+		eventType        = "post.created"
+		replyEventType   = "post.replied"
+		mentionEventType = "post.mentioned"
 	)
 
 	// marshal the post payload for outbox
@@ -43,11 +56,29 @@ func (r *PostRepository) CreatePost(ctx context.Context, post *postv1.Post) erro
 
 	// insert post
 
-	batch.Query(insertPostQuery, post.Id, post.User.Id, post.Content, post.ImageUrl, post.CreatedAt.AsTime())
+	batch.Query(insertPostQuery, post.Id, post.User.Id, post.Content, post.ImageUrl, post.CreatedAt.AsTime(), int32(post.Audience), post.ReplyToPostId, post.RootPostId, post.QuotePostId, post.Hashtags, mentionsOf(post), mediaOf(post))
 
 	// insert outbox event
 	batch.Query(insertOutboxQuery, eventType, payload)
 
+	// replies also update the parent's reply index and comment count
+	if post.ReplyToPostId != 0 {
+		batch.Query(insertOutboxQuery, replyEventType, payload)
+	}
+
+	for _, userId := range notify {
+		mention, err := protojson.Marshal(&postv1.MentionedEvent{
+			PostId:          post.Id,
+			AuthorId:        post.User.GetId(),
+			MentionedUserId: userId,
+			CreatedAt:       post.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal mention for outbox: %w", err)
+		}
+		batch.Query(insertOutboxQuery, mentionEventType, mention)
+	}
+
 	// execute batch
 	if err := r.session.ExecuteBatch(batch); err != nil {
 		return fmt.Errorf("failed to execute post creation batch: %w", err)
@@ -56,41 +87,115 @@ func (r *PostRepository) CreatePost(ctx context.Context, post *postv1.Post) erro
 
 }
 
-func (r *PostRepository) GetPost(ctx context.Context, postId int64) (*postv1.Post, error) {
-	query := `
-		SELECT post_id, user_id, content, image_url, created_at 
-		FROM threads_keyspace.posts 
-		WHERE post_id = ? 
-		LIMIT 1
-	`
+// postColumns are the posts table columns read by scanPost, in order.
+const postColumns = `post_id, user_id, content, image_url, created_at, audience, reply_to_post_id, root_post_id, quote_post_id, edited_at, hashtags, mentions, media`
 
+// scanPost reads one row selected with postColumns.
+func scanPost(scan func(dest ...interface{}) error) (*postv1.Post, error) {
 	var (
 		post      postv1.Post
 		createdAt time.Time
+		editedAt  time.Time
+		audience  int32
+		mentions  map[string]int64
+		media     []postMedia
 	)
 
 	post.User = &userv1.User{} // Initialize User to avoid nil pointer dereference
-	err := r.session.
+	if err := scan(&post.Id, &post.User.Id, &post.Content, &post.ImageUrl, &createdAt, &audience, &post.ReplyToPostId, &post.RootPostId, &post.QuotePostId, &editedAt, &post.Hashtags, &mentions, &media); err != nil {
+		return nil, err
+	}
+
+	post.CreatedAt = timestamppb.New(createdAt)
+	post.EditedAt = editedTimestamp(editedAt)
+	post.Audience = postv1.Audience(audience)
+	setEntities(&post, mentions)
+	setMedia(&post, media)
+
+	return &post, nil
+}
+
+// editedTimestamp converts a scanned edited_at, which is zero for posts never edited.
+func editedTimestamp(editedAt time.Time) *timestamppb.Timestamp {
+	if editedAt.IsZero() {
+		return nil
+	}
+	return timestamppb.New(editedAt)
+}
+
+func (r *PostRepository) GetPost(ctx context.Context, postId int64) (*postv1.Post, error) {
+	query := `
+		SELECT ` + postColumns + `
+		FROM threads_keyspace.posts 
+		WHERE post_id = ? 
+		LIMIT 1
+	`
+
+	post, err := scanPost(r.session.
 		Query(query, postId).
 		WithContext(ctx).
 		Consistency(gocql.One).
-		Scan(&post.Id, &post.User.Id, &post.Content, &post.ImageUrl, &createdAt)
+		Scan)
 
 	if err != nil {
 		if err == gocql.ErrNotFound {
-			return nil, fmt.Errorf("failed to find post")
+			return nil, ErrPostNotFound
 		}
 		return nil, err
 	}
 
-	post.CreatedAt = timestamppb.New(createdAt)
+	return post, nil
+}
 
-	return &post, nil
+// GetPostsByIDs reads posts in chunked IN queries. Ids with no post are left out.
+func (r *PostRepository) GetPostsByIDs(ctx context.Context, postIds []int64) (map[int64]*postv1.Post, error) {
+	query := `SELECT ` + postColumns + ` FROM threads_keyspace.posts WHERE post_id IN ?`
+
+	posts := make(map[int64]*postv1.Post, len(postIds))
+	for chunk := range database.ChunkIDs(postIds) {
+		scanner := r.session.Query(query, chunk).WithContext(ctx).Iter().Scanner()
+		for scanner.Next() {
+			post, err := scanPost(scanner.Scan)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan post: %w", err)
+			}
+			posts[post.Id] = post
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to get posts: %w", err)
+		}
+	}
+
+	return posts, nil
 }
 
-func (r *PostRepository) DeletePost(ctx context.Context, postId int64) error {
-	query := `DELETE FROM threads_keyspace.posts WHERE post_id = ?`
-	return r.session.Query(query, postId).WithContext(ctx).Exec()
+// ScanPosts pages through the whole posts table, in token order.
+func (r *PostRepository) ScanPosts(ctx context.Context, pageSize int, pagingState []byte) ([]*postv1.Post, []byte, error) {
+	query := `SELECT ` + postColumns + ` FROM threads_keyspace.posts`
+
+	iter := r.session.Query(query).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var posts []*postv1.Post
+	scanner := iter.Scanner()
+	for scanner.Next() {
+		post, err := scanPost(scanner.Scan)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan post: %w", err)
+		}
+		posts = append(posts, post)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to scan posts: %w", err)
+	}
+
+	return posts, nextPageState, nil
 }
 
 func (r *PostRepository) ListPostsByUser(
@@ -99,19 +204,35 @@ func (r *PostRepository) ListPostsByUser(
 	pageSize int32,
 	pagingState []byte,
 ) (*postv1.ListPostsByUserResponse, error) {
-	query := `SELECT post_id, user_id, content, image_url, created_at FROM threads_keyspace.posts_by_user WHERE user_id = ?`
+	return r.ListPostsByUserBefore(ctx, userId, 0, pageSize, pagingState)
+}
 
-	q := r.session.
-		Query(query, userId).
-		WithContext(ctx).
-		PageSize(int(pageSize)).
-		Consistency(gocql.One)
+// ListPostsByUserBefore pages through the user's posts older than beforeId, newest
+// first. A zero beforeId starts at the newest post.
+func (r *PostRepository) ListPostsByUserBefore(
+	ctx context.Context,
+	userId int64,
+	beforeId int64,
+	pageSize int32,
+	pagingState []byte,
+) (*postv1.ListPostsByUserResponse, error) {
+	query := `SELECT post_id, user_id, content, image_url, created_at, audience, reply_to_post_id, root_post_id, quote_post_id, repost_of_post_id, edited_at, mentions, media FROM threads_keyspace.posts_by_user WHERE user_id = ?`
 
-	if len(pagingState) > 0 {
-		q = q.PageState(pagingState)
+	args := []interface{}{userId}
+	if beforeId != 0 {
+		query += ` AND post_id < ?`
+		args = append(args, beforeId)
 	}
 
-	iter := q.Iter()
+	// Always set the page state: it also turns off auto-paging, without which the first
+	// page would iterate the whole partition.
+	iter := r.session.
+		Query(query, args...).
+		WithContext(ctx).
+		PageSize(int(pageSize)).
+		PageState(pagingState).
+		Consistency(gocql.One).
+		Iter()
 	defer iter.Close()
 
 	var (
@@ -121,18 +242,34 @@ func (r *PostRepository) ListPostsByUser(
 		content   string
 		imageURL  string
 		createdAt time.Time
+		audience  int32
+		replyTo   int64
+		rootID    int64
+		quoteID   int64
+		repostOf  int64
+		editedAt  time.Time
+		mentions  map[string]int64
+		media     []postMedia
 	)
 
-	for iter.Scan(&postID, &uid, &content, &imageURL, &createdAt) {
+	for iter.Scan(&postID, &uid, &content, &imageURL, &createdAt, &audience, &replyTo, &rootID, &quoteID, &repostOf, &editedAt, &mentions, &media) {
 		post := &postv1.Post{
 			Id: postID,
 			User: &userv1.User{
 				Id: uid,
 			},
-			Content:   content,
-			ImageUrl:  imageURL,
-			CreatedAt: timestamppb.New(createdAt),
+			Content:        content,
+			ImageUrl:       imageURL,
+			CreatedAt:      timestamppb.New(createdAt),
+			Audience:       postv1.Audience(audience),
+			ReplyToPostId:  replyTo,
+			RootPostId:     rootID,
+			QuotePostId:    quoteID,
+			RepostOfPostId: repostOf,
+			EditedAt:       editedTimestamp(editedAt),
 		}
+		setEntities(post, mentions)
+		setMedia(post, media)
 		posts = append(posts, post)
 	}
 
@@ -146,11 +283,15 @@ func (r *PostRepository) ListPostsByUser(
 	}, nil
 }
 
+// CreatePostIndexedByUser writes the post's posts_by_user row. The write is timestamped
+// with the post's creation time so a post.edited or post.deleted event applied first
+// is not overwritten.
 func (r *PostRepository) CreatePostIndexedByUser(ctx context.Context, post *postv1.Post) error {
 	query := `
 		INSERT INTO threads_keyspace.posts_by_user 
-		(post_id, user_id, content, image_url, created_at) 
-		VALUES (?, ?, ?, ?, ?)`
+		(post_id, user_id, content, image_url, created_at, audience, reply_to_post_id, root_post_id, quote_post_id, mentions, media) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		USING TIMESTAMP ?`
 
 	err := r.session.Query(query,
 		post.Id,
@@ -158,6 +299,13 @@ func (r *PostRepository) CreatePostIndexedByUser(ctx context.Context, post *post
 		post.Content,
 		post.ImageUrl,
 		post.CreatedAt.AsTime(), // assuming created_at is a google.protobuf.Timestamp
+		int32(post.Audience),
+		post.ReplyToPostId,
+		post.RootPostId,
+		post.QuotePostId,
+		mentionsOf(post),
+		mediaOf(post),
+		post.CreatedAt.AsTime().UnixMicro(),
 	).WithContext(ctx).Exec()
 
 	if err != nil {
@@ -171,7 +319,8 @@ func (r *PostRepository) InsertEngagementsCount(ctx context.Context, postId int6
 		UPDATE threads_keyspace.post_engagements
 		SET like_count = like_count + 0,
 		    comment_count = comment_count + 0,
-		    share_count = share_count + 0
+		    share_count = share_count + 0,
+		    repost_count = repost_count + 0
 		WHERE post_id = ?`
 
 	if err := r.session.Query(query, postId).WithContext(ctx).Exec(); err != nil {
@@ -182,7 +331,7 @@ func (r *PostRepository) InsertEngagementsCount(ctx context.Context, postId int6
 
 func (r *PostRepository) SelectEngagementCounts(ctx context.Context, postId int64) (*postv1.PostEngagements, error) {
 	query := `
-		SELECT like_count, share_count, comment_count
+		SELECT like_count, share_count, comment_count, repost_count
 		FROM threads_keyspace.post_engagements
 		WHERE post_id = ? LIMIT 1
 	`
@@ -193,6 +342,7 @@ func (r *PostRepository) SelectEngagementCounts(ctx context.Context, postId int6
 		&postEngagement.LikeCount,
 		&postEngagement.ShareCount,
 		&postEngagement.CommentCount,
+		&postEngagement.RepostCount,
 	); err != nil {
 		if err == gocql.ErrNotFound {
 			return &postv1.PostEngagements{
@@ -208,60 +358,362 @@ func (r *PostRepository) SelectEngagementCounts(ctx context.Context, postId int6
 	return &postEngagement, nil
 }
 
+// CreateUserLike indexes the like by user, in likes_by_user and in the liked_at ordered
+// liked_posts_by_user. The rows are written at the like's own time so that a like.deleted
+// processed first still wins; see DeleteUserLike.
 func (r *PostRepository) CreateUserLike(ctx context.Context, like *postv1.Like) error {
-	const likeQuery = `
-		INSERT INTO threads_keyspace.likes_by_user
-		(post_id, user_id, liked_at) 
-		VALUES (?, ?, ?)`
-
-	if err := r.session.Query(likeQuery,
-		like.PostId,
-		like.UserId,
-		like.CreatedAt.AsTime(),
-	).WithContext(ctx).Exec(); err != nil {
+	const (
+		likeQuery = `
+			INSERT INTO threads_keyspace.likes_by_user
+			(post_id, user_id, liked_at) 
+			VALUES (?, ?, ?)
+			USING TIMESTAMP ?`
+
+		likedPostQuery = `
+			INSERT INTO threads_keyspace.liked_posts_by_user
+			(user_id, liked_at, post_id)
+			VALUES (?, ?, ?)
+			USING TIMESTAMP ?`
+	)
+
+	likedAt := like.CreatedAt.AsTime()
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(likeQuery, like.PostId, like.UserId, likedAt, likedAt.UnixMicro())
+	batch.Query(likedPostQuery, like.UserId, likedAt, like.PostId, likedAt.UnixMicro())
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
 		return fmt.Errorf("failed to insert like into likes_by_user: %w", err)
 	}
 
 	return nil
 }
 
-func (r *PostRepository) CreateLike(ctx context.Context, like *postv1.Like) error {
+// ListLikesByPost pages through the ids of users who liked a post.
+func (r *PostRepository) ListLikesByPost(ctx context.Context, postId int64, pageSize int32, pagingState []byte) ([]int64, []byte, error) {
+	query := `SELECT user_id FROM threads_keyspace.likes_by_post WHERE post_id = ?`
+
+	iter := r.session.Query(query, postId).
+		WithContext(ctx).
+		PageSize(int(pageSize)).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		userIDs []int64
+		userID  int64
+	)
+	for iter.Scan(&userID) {
+		userIDs = append(userIDs, userID)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list likes of post %d: %w", postId, err)
+	}
+
+	return userIDs, nextPageState, nil
+}
+
+// ListLikedPostsByUser pages through a user's likes, most recent first.
+func (r *PostRepository) ListLikedPostsByUser(ctx context.Context, userId int64, pageSize int32, pagingState []byte) ([]*postv1.Like, []byte, error) {
+	query := `SELECT post_id, liked_at FROM threads_keyspace.liked_posts_by_user WHERE user_id = ?`
+
+	iter := r.session.Query(query, userId).
+		WithContext(ctx).
+		PageSize(int(pageSize)).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		likes   []*postv1.Like
+		postID  int64
+		likedAt time.Time
+	)
+	for iter.Scan(&postID, &likedAt) {
+		likes = append(likes, &postv1.Like{
+			PostId:    postID,
+			UserId:    userId,
+			CreatedAt: timestamppb.New(likedAt),
+		})
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list liked posts of user %d: %w", userId, err)
+	}
+
+	return likes, nextPageState, nil
+}
+
+// ScanUserLikes reads one page of the whole likes_by_user table.
+func (r *PostRepository) ScanUserLikes(ctx context.Context, pageSize int, pagingState []byte) ([]*postv1.Like, []byte, error) {
+	query := `SELECT user_id, post_id, liked_at FROM threads_keyspace.likes_by_user`
+
+	iter := r.session.Query(query).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		likes          []*postv1.Like
+		userID, postID int64
+		likedAt        time.Time
+	)
+	for iter.Scan(&userID, &postID, &likedAt) {
+		likes = append(likes, &postv1.Like{
+			PostId:    postID,
+			UserId:    userID,
+			CreatedAt: timestamppb.New(likedAt),
+		})
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return likes, nextPageState, nil
+}
+
+// IndexLikedPosts writes the likes to liked_posts_by_user. Rows are upserts at the like's
+// own time, like CreateUserLike, so copying a like that is already there, or one that
+// has since been deleted, is harmless.
+func (r *PostRepository) IndexLikedPosts(ctx context.Context, likes []*postv1.Like) error {
+	query := `INSERT INTO threads_keyspace.liked_posts_by_user (user_id, liked_at, post_id) VALUES (?, ?, ?) USING TIMESTAMP ?`
+
+	for _, like := range likes {
+		likedAt := like.CreatedAt.AsTime()
+		if err := r.session.Query(query, like.UserId, likedAt, like.PostId, likedAt.UnixMicro()).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to index liked post %d for user %d: %w", like.PostId, like.UserId, err)
+		}
+	}
+	return nil
+}
+
+// CreateLike records the like in likes_by_post and writes a like.created outbox event.
+// The row is inserted with a lightweight transaction, so liking a post twice returns
+// the existing like with created=false and emits nothing. A like landing on a deleted
+// post is taken back out and reported as ErrPostNotFound.
+//
+// The row carries the id of its like.created event and is marked emitted once the event
+// is written. If a call dies in between, the next like of the post finds the row still
+// pending and writes the event again under the same id, which consumers apply once.
+func (r *PostRepository) CreateLike(ctx context.Context, like *postv1.Like) (*postv1.Like, bool, error) {
 	const (
 		likeQuery = `
 			INSERT INTO threads_keyspace.likes_by_post 
-			(post_id, user_id, liked_at) 
-			VALUES (?, ?, ?)`
+			(post_id, user_id, liked_at, event_id) 
+			VALUES (?, ?, ?, ?)
+			IF NOT EXISTS`
+
+		deleteLikeQuery = `DELETE FROM threads_keyspace.likes_by_post WHERE post_id = ? AND user_id = ? IF liked_at = ?`
+
+		emittedQuery = `UPDATE threads_keyspace.likes_by_post SET emitted = true WHERE post_id = ? AND user_id = ? IF liked_at = ?`
 
 		insertOutboxQuery = `
 			INSERT INTO threads_keyspace.outbox 
 			(event_id, event_type, payload, published) 
-			VALUES (uuid(), ?, ?, false) 
+			VALUES (?, ?, ?, false) 
 			USING TTL 86400`
 
 		eventType = "like.created"
 	)
 
-	// Marshal the like payload for outbox
+	eventID := gocql.TimeUUID()
+	existing := map[string]interface{}{}
+	applied, err := r.session.Query(likeQuery, like.PostId, like.UserId, like.CreatedAt.AsTime(), eventID).
+		WithContext(ctx).
+		MapScanCAS(existing)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to insert like into likes_by_post: %w", err)
+	}
+	if !applied {
+		stored, storedEventID, pending := existingLike(like.PostId, like.UserId, existing)
+		if !pending {
+			return stored, false, nil
+		}
+		like, eventID = stored, storedEventID
+	}
+	likedAt := like.CreatedAt.AsTime()
+
+	// The tombstone is written before the post's likes are purged, so checking after
+	// the insert catches a deletion the purge could have run ahead of.
+	deleted, err := r.IsPostDeleted(ctx, like.PostId)
+	if err == nil && deleted {
+		err = ErrPostNotFound
+	}
+	if err != nil {
+		if _, delErr := r.session.Query(deleteLikeQuery, like.PostId, like.UserId, likedAt).WithContext(ctx).MapScanCAS(map[string]interface{}{}); delErr != nil {
+			slog.Error("failed to roll back like", "post_id", like.PostId, "user_id", like.UserId, "error", delErr)
+		}
+		return nil, false, err
+	}
+
 	payload, err := protojson.Marshal(like)
 	if err != nil {
-		return fmt.Errorf("failed to marshal like for outbox: %w", err)
+		return nil, false, fmt.Errorf("failed to marshal like for outbox: %w", err)
 	}
 
-	// Create a logged batch for atomic execution
-	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	// A conditional insert can't share a batch with the outbox row (different partitions).
+	// If either write below fails the like stays pending, and a retry finishes it.
+	if err := r.session.Query(insertOutboxQuery, eventID, eventType, payload).WithContext(ctx).Exec(); err != nil {
+		return nil, false, fmt.Errorf("failed to write like outbox event: %w", err)
+	}
+
+	// Not applying means the like was removed meanwhile, and the unlike wrote this event.
+	if _, err := r.session.Query(emittedQuery, like.PostId, like.UserId, likedAt).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+		return nil, false, fmt.Errorf("failed to mark like event written: %w", err)
+	}
+
+	return like, true, nil
+}
 
-	// Insert into likes_by_post
-	batch.Query(likeQuery, like.PostId, like.UserId, like.CreatedAt.AsTime())
+// existingLike reads the likes_by_post row returned by a conditional insert that did
+// not apply. pending reports that the like's like.created event may not have been
+// written.
+func existingLike(postId, userId int64, row map[string]interface{}) (like *postv1.Like, eventID gocql.UUID, pending bool) {
+	likedAt, _ := row["liked_at"].(time.Time)
+	eventID, _ = row["event_id"].(gocql.UUID)
+	emitted, _ := row["emitted"].(bool)
 
-	// Insert into outbox
+	like = &postv1.Like{
+		PostId:    postId,
+		UserId:    userId,
+		CreatedAt: timestamppb.New(likedAt),
+	}
+	return like, eventID, likeEventPending(eventID, emitted)
+}
+
+// likeEventPending reports whether a likes_by_post row's like.created event may not
+// have been written. Rows from before event ids were stored have none and never are.
+func likeEventPending(eventID gocql.UUID, emitted bool) bool {
+	return eventID != (gocql.UUID{}) && !emitted
+}
+
+// DeleteLike removes the like from likes_by_post together with a like.deleted outbox
+// event. It reports false, and writes nothing, when the user had not liked the post.
+// A like whose like.created event may not have been written gets it in the same batch,
+// under its own id, so the counter never goes below the likes it counted.
+func (r *PostRepository) DeleteLike(ctx context.Context, postId, userId int64) (bool, error) {
+	const (
+		selectQuery = `SELECT liked_at, event_id, emitted FROM threads_keyspace.likes_by_post WHERE post_id = ? AND user_id = ?`
+
+		releaseQuery = `DELETE FROM threads_keyspace.likes_by_post WHERE post_id = ? AND user_id = ? IF liked_at = ?`
+
+		restoreQuery = `INSERT INTO threads_keyspace.likes_by_post (post_id, user_id, liked_at, event_id, emitted) VALUES (?, ?, ?, ?, ?) IF NOT EXISTS`
+
+		insertCreatedQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (?, 'like.created', ?, false) USING TTL 86400`
+
+		insertOutboxQuery = `
+			INSERT INTO threads_keyspace.outbox 
+			(event_id, event_type, payload, published) 
+			VALUES (uuid(), ?, ?, false) 
+			USING TTL 86400`
+
+		eventType = "like.deleted"
+	)
+
+	var (
+		likedAt time.Time
+		eventID gocql.UUID
+		emitted bool
+	)
+	if err := r.session.Query(selectQuery, postId, userId).WithContext(ctx).Scan(&likedAt, &eventID, &emitted); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get like on post %d: %w", postId, err)
+	}
+
+	like := &postv1.Like{
+		PostId:    postId,
+		UserId:    userId,
+		CreatedAt: timestamppb.New(likedAt),
+	}
+	created, err := protojson.Marshal(like)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal like for outbox: %w", err)
+	}
+	like.DeletedAt = timestamppb.Now()
+	payload, err := protojson.Marshal(like)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal like for outbox: %w", err)
+	}
+
+	// Only the caller that actually removes the row emits the event, so concurrent
+	// unlikes decrement the counter once. The condition is on the like that was read,
+	// so a re-like in between is left alone.
+	applied, err := r.session.Query(releaseQuery, postId, userId, likedAt).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete like on post %d: %w", postId, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	if likeEventPending(eventID, emitted) {
+		batch.Query(insertCreatedQuery, eventID, created)
+	}
 	batch.Query(insertOutboxQuery, eventType, payload)
 
-	// Execute the batch
 	if err := r.session.ExecuteBatch(batch); err != nil {
-		return fmt.Errorf("failed to execute like creation batch: %w", err)
+		// Put the row back so a retry finds the like again.
+		if _, resErr := r.session.Query(restoreQuery, postId, userId, likedAt, eventID, emitted).WithContext(ctx).MapScanCAS(map[string]interface{}{}); resErr != nil {
+			slog.Error("failed to restore like after unlike failure", "post_id", postId, "user_id", userId, "error", resErr)
+		}
+		return false, fmt.Errorf("failed to execute like deletion batch: %w", err)
 	}
 
-	return nil
+	return true, nil
+}
+
+// DeleteUserLike applies a like.deleted event: it removes the likes_by_user and
+// liked_posts_by_user rows and takes the like off like_count once per event.
+//
+// like.created and like.deleted for the same like may arrive in either order. Both
+// write with the event time as the cell timestamp, so the delete wins over a late
+// insert of the like it removed but not over a later like, and each event moves the
+// counter exactly once, so the count comes out the same in either order.
+func (r *PostRepository) DeleteUserLike(ctx context.Context, like *postv1.Like, eventId string) (bool, error) {
+	const (
+		deleteQuery = `DELETE FROM threads_keyspace.likes_by_user USING TIMESTAMP ? WHERE user_id = ? AND post_id = ?`
+
+		deleteLikedPostQuery = `DELETE FROM threads_keyspace.liked_posts_by_user USING TIMESTAMP ? WHERE user_id = ? AND liked_at = ? AND post_id = ?`
+	)
+
+	ts := unlikeTimestamp(like, time.Now())
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(deleteQuery, ts, like.UserId, like.PostId)
+	batch.Query(deleteLikedPostQuery, ts, like.UserId, like.CreatedAt.AsTime(), like.PostId)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return false, fmt.Errorf("failed to delete like of user %d on post %d: %w", like.UserId, like.PostId, err)
+	}
+
+	// A deleted post's counters are gone and must not be written again.
+	deleted, err := r.IsPostDeleted(ctx, like.PostId)
+	if err != nil || deleted {
+		return false, err
+	}
+
+	return r.DecrementEngagementCountOnce(ctx, like.PostId, "like_count", eventId)
+}
+
+// unlikeTimestamp is the cell timestamp a like.deleted event writes with: the time of
+// the unlike, or now for events written before deleted_at existed. CreateUserLike
+// writes with the like time, which is always earlier for the same like.
+func unlikeTimestamp(like *postv1.Like, now time.Time) int64 {
+	if like.DeletedAt != nil {
+		return like.DeletedAt.AsTime().UnixMicro()
+	}
+	return now.UnixMicro()
 }
 
 func (r *PostRepository) SafeIncrementEngagementCounts(ctx context.Context, postId int64, column string) error {
@@ -269,6 +721,7 @@ func (r *PostRepository) SafeIncrementEngagementCounts(ctx context.Context, post
 		"comment_count": `UPDATE threads_keyspace.post_engagements SET comment_count = comment_count + 1 WHERE post_id = ?`,
 		"like_count":    `UPDATE threads_keyspace.post_engagements SET like_count = like_count + 1 WHERE post_id = ?`,
 		"share_count":   `UPDATE threads_keyspace.post_engagements SET share_count = share_count + 1 WHERE post_id = ?`,
+		"repost_count":  `UPDATE threads_keyspace.post_engagements SET repost_count = repost_count + 1 WHERE post_id = ?`,
 	}
 
 	query, ok := queries[column]
@@ -285,3 +738,449 @@ func (r *PostRepository) SafeIncrementEngagementCounts(ctx context.Context, post
 	slog.Debug("successfully incremented counter", "post_id", postId, "column", column)
 	return nil
 }
+
+// IncrementEngagementCountOnce increments column for the post unless eventId has
+// already been applied to it. The event is claimed in processed_events with a lightweight
+// transaction and released again if the increment fails, so a retry can apply it.
+func (r *PostRepository) IncrementEngagementCountOnce(ctx context.Context, postId int64, column, eventId string) (bool, error) {
+	return r.applyOnce(ctx, "post_engagements."+column, eventId, func() error {
+		return r.SafeIncrementEngagementCounts(ctx, postId, column)
+	})
+}
+
+// DecrementEngagementCountOnce decrements column for the post unless eventId has
+// already been applied to it.
+func (r *PostRepository) DecrementEngagementCountOnce(ctx context.Context, postId int64, column, eventId string) (bool, error) {
+	return r.applyOnce(ctx, "post_engagements."+column, eventId, func() error {
+		return r.SafeDecrementEngagementCounts(ctx, postId, column)
+	})
+}
+
+// applyOnce runs apply unless consumer has already processed eventId. The event is
+// claimed in processed_events with a lightweight transaction and released again if
+// apply fails, so a retry can run it. It reports whether apply ran.
+func (r *PostRepository) applyOnce(ctx context.Context, consumer, eventId string, apply func() error) (bool, error) {
+	const (
+		claimQuery = `
+			INSERT INTO threads_keyspace.processed_events (consumer, event_id, processed_at)
+			VALUES (?, ?, ?)
+			IF NOT EXISTS
+			USING TTL 604800`
+
+		releaseQuery = `DELETE FROM threads_keyspace.processed_events WHERE consumer = ? AND event_id = ?`
+	)
+
+	applied, err := r.session.Query(claimQuery, consumer, eventId, time.Now()).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim event %s: %w", eventId, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	if err := apply(); err != nil {
+		if relErr := r.session.Query(releaseQuery, consumer, eventId).WithContext(ctx).Exec(); relErr != nil {
+			slog.Error("failed to release event claim", "event_id", eventId, "consumer", consumer, "error", relErr)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SafeDecrementEngagementCounts undoes SafeIncrementEngagementCounts for the counters
+// that can be taken back.
+func (r *PostRepository) SafeDecrementEngagementCounts(ctx context.Context, postId int64, column string) error {
+	queries := map[string]string{
+		"comment_count": `UPDATE threads_keyspace.post_engagements SET comment_count = comment_count - 1 WHERE post_id = ?`,
+		"like_count":    `UPDATE threads_keyspace.post_engagements SET like_count = like_count - 1 WHERE post_id = ?`,
+		"repost_count":  `UPDATE threads_keyspace.post_engagements SET repost_count = repost_count - 1 WHERE post_id = ?`,
+	}
+
+	query, ok := queries[column]
+	if !ok {
+		return fmt.Errorf("invalid column name: %q", column)
+	}
+
+	if err := r.session.Query(query, postId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to decrement %s for post %d: %w", column, postId, err)
+	}
+	return nil
+}
+
+// IncrementUserPostCountOnce adds a new post to the user's post count unless the
+// post.created event has already been applied.
+func (r *PostRepository) IncrementUserPostCountOnce(ctx context.Context, userId int64, eventId string) (bool, error) {
+	query := `UPDATE threads_keyspace.post_counts SET post_count = post_count + 1 WHERE user_id = ?`
+
+	return r.applyOnce(ctx, "post_counts", eventId, func() error {
+		if err := r.session.Query(query, userId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to increment post count for user %d: %w", userId, err)
+		}
+		return nil
+	})
+}
+
+// DecrementUserPostCountOnce takes a deleted post off the user's post count unless the
+// post.deleted event has already been applied.
+func (r *PostRepository) DecrementUserPostCountOnce(ctx context.Context, userId int64, eventId string) (bool, error) {
+	query := `UPDATE threads_keyspace.post_counts SET post_count = post_count - 1 WHERE user_id = ?`
+
+	return r.applyOnce(ctx, "post_counts", eventId, func() error {
+		if err := r.session.Query(query, userId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to decrement post count for user %d: %w", userId, err)
+		}
+		return nil
+	})
+}
+
+// IsCloseFriend reports whether friendId is on ownerId's close friends list. The list
+// is owned by the user-service; it is read here directly so membership never has to be
+// exposed through a user-facing RPC.
+func (r *PostRepository) IsCloseFriend(ctx context.Context, ownerId, friendId int64) (bool, error) {
+	query := `SELECT friend_id FROM threads_keyspace.close_friends_by_user WHERE user_id = ? AND friend_id = ?`
+
+	var id int64
+	if err := r.session.Query(query, ownerId, friendId).WithContext(ctx).Scan(&id); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check close friend %d of user %d: %w", friendId, ownerId, err)
+	}
+	return true, nil
+}
+
+// BlockedAuthorBy reports which of userIds have blocked authorId. Blocks are owned by
+// the user-service; blocked_by_users is read here directly, like close_friends_by_user.
+func (r *PostRepository) BlockedAuthorBy(ctx context.Context, authorId int64, userIds []int64) (map[int64]bool, error) {
+	query := `SELECT blocker_id FROM threads_keyspace.blocked_by_users WHERE user_id = ? AND blocker_id IN ?`
+
+	blockers := make(map[int64]bool)
+	for chunk := range database.ChunkIDs(userIds) {
+		iter := r.session.Query(query, authorId, chunk).WithContext(ctx).Iter()
+
+		var blockerId int64
+		for iter.Scan(&blockerId) {
+			blockers[blockerId] = true
+		}
+
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to look up blockers of user %d: %w", authorId, err)
+		}
+	}
+	return blockers, nil
+}
+
+// IsFollowing reports whether userId currently follows authorId. following_by_user is
+// owned by the user-service and read here directly, like close_friends_by_user.
+func (r *PostRepository) IsFollowing(ctx context.Context, userId, authorId int64) (bool, error) {
+	query := `SELECT following_id FROM threads_keyspace.following_by_user WHERE user_id = ? AND following_id = ?`
+
+	var id int64
+	if err := r.session.Query(query, userId, authorId).WithContext(ctx).Scan(&id); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check whether user %d follows %d: %w", userId, authorId, err)
+	}
+	return true, nil
+}
+
+// CreateReplyIndexedByPost adds the reply to its parent's replies_by_post partition and
+// bumps the parent's comment_count. The insert is a lightweight transaction so a
+// redelivered post.replied event finds the row and leaves the counter alone; it reports
+// whether this call did the work.
+func (r *PostRepository) CreateReplyIndexedByPost(ctx context.Context, reply *postv1.Post) (bool, error) {
+	const (
+		insertReplyQuery = `
+			INSERT INTO threads_keyspace.replies_by_post
+			(post_id, reply_id, user_id, content, image_url, created_at, audience, root_post_id, quote_post_id, mentions, media)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			IF NOT EXISTS`
+
+		deleteReplyQuery = `DELETE FROM threads_keyspace.replies_by_post WHERE post_id = ? AND reply_id = ?`
+	)
+
+	applied, err := r.session.Query(insertReplyQuery,
+		reply.ReplyToPostId,
+		reply.Id,
+		reply.User.GetId(),
+		reply.Content,
+		reply.ImageUrl,
+		reply.CreatedAt.AsTime(),
+		int32(reply.Audience),
+		reply.RootPostId,
+		reply.QuotePostId,
+		mentionsOf(reply),
+		mediaOf(reply),
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to index reply %d under post %d: %w", reply.Id, reply.ReplyToPostId, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	if err := r.SafeIncrementEngagementCounts(ctx, reply.ReplyToPostId, "comment_count"); err != nil {
+		// Undo the marker so the retried event counts the reply.
+		if delErr := r.session.Query(deleteReplyQuery, reply.ReplyToPostId, reply.Id).WithContext(ctx).Exec(); delErr != nil {
+			slog.Error("failed to roll back reply index", "post_id", reply.ReplyToPostId, "reply_id", reply.Id, "error", delErr)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ThreadReply links a reply to its parent within a conversation.
+type ThreadReply struct {
+	ID       int64
+	ParentID int64
+}
+
+// IndexThreadReply adds the reply to its conversation's replies_by_root partition.
+func (r *PostRepository) IndexThreadReply(ctx context.Context, reply *postv1.Post) error {
+	query := `INSERT INTO threads_keyspace.replies_by_root (root_post_id, reply_id, reply_to_post_id) VALUES (?, ?, ?)`
+
+	if err := r.session.Query(query, reply.RootPostId, reply.Id, reply.ReplyToPostId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to index reply %d under conversation %d: %w", reply.Id, reply.RootPostId, err)
+	}
+	return nil
+}
+
+// ListThreadReplies returns up to limit replies in the conversation started by rootId
+// that were posted after afterId, oldest first.
+func (r *PostRepository) ListThreadReplies(ctx context.Context, rootId, afterId int64, limit int) ([]ThreadReply, error) {
+	query := `
+		SELECT reply_id, reply_to_post_id
+		FROM threads_keyspace.replies_by_root
+		WHERE root_post_id = ? AND reply_id > ?
+		LIMIT ?`
+
+	iter := r.session.Query(query, rootId, afterId, limit).WithContext(ctx).Iter()
+
+	var (
+		replies []ThreadReply
+		reply   ThreadReply
+	)
+	for iter.Scan(&reply.ID, &reply.ParentID) {
+		replies = append(replies, reply)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list replies in conversation %d: %w", rootId, err)
+	}
+	return replies, nil
+}
+
+// ListReplies pages through the direct replies to a post, oldest first.
+func (r *PostRepository) ListReplies(ctx context.Context, postId int64, pageSize int32, pagingState []byte) ([]*postv1.Post, []byte, error) {
+	query := `
+		SELECT reply_id, user_id, content, image_url, created_at, audience, root_post_id, quote_post_id, edited_at, mentions, media
+		FROM threads_keyspace.replies_by_post
+		WHERE post_id = ?`
+
+	iter := r.session.Query(query, postId).
+		WithContext(ctx).
+		PageSize(int(pageSize)).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		replies   []*postv1.Post
+		replyID   int64
+		uid       int64
+		content   string
+		imageURL  string
+		createdAt time.Time
+		audience  int32
+		rootID    int64
+		quoteID   int64
+		editedAt  time.Time
+		mentions  map[string]int64
+		media     []postMedia
+	)
+
+	for iter.Scan(&replyID, &uid, &content, &imageURL, &createdAt, &audience, &rootID, &quoteID, &editedAt, &mentions, &media) {
+		reply := &postv1.Post{
+			Id:            replyID,
+			User:          &userv1.User{Id: uid},
+			Content:       content,
+			ImageUrl:      imageURL,
+			CreatedAt:     timestamppb.New(createdAt),
+			Audience:      postv1.Audience(audience),
+			ReplyToPostId: postId,
+			RootPostId:    rootID,
+			QuotePostId:   quoteID,
+			EditedAt:      editedTimestamp(editedAt),
+		}
+		setEntities(reply, mentions)
+		setMedia(reply, media)
+		replies = append(replies, reply)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list replies to post %d: %w", postId, err)
+	}
+
+	return replies, nextPageState, nil
+}
+
+// CreateRepost records that the user reposted a post and adds the repost entry to the
+// user's posts_by_user partition. reposts_by_post is claimed with a lightweight
+// transaction so a user holds at most one repost per post; when one already exists it
+// is returned with created=false.
+func (r *PostRepository) CreateRepost(ctx context.Context, repost *postv1.Repost) (*postv1.Repost, bool, error) {
+	const (
+		claimQuery = `
+			INSERT INTO threads_keyspace.reposts_by_post
+			(post_id, user_id, repost_id, reposted_at)
+			VALUES (?, ?, ?, ?)
+			IF NOT EXISTS`
+
+		releaseQuery = `DELETE FROM threads_keyspace.reposts_by_post WHERE post_id = ? AND user_id = ?`
+
+		insertEntryQuery = `
+			INSERT INTO threads_keyspace.posts_by_user
+			(post_id, user_id, content, image_url, created_at, audience, repost_of_post_id)
+			VALUES (?, ?, '', '', ?, ?, ?)`
+
+		insertOutboxQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (uuid(), ?, ?, false) USING TTL 86400`
+
+		eventType = "post.reposted"
+	)
+
+	existing := map[string]interface{}{}
+	applied, err := r.session.Query(claimQuery,
+		repost.PostId, repost.UserId, repost.RepostId, repost.CreatedAt.AsTime(),
+	).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to claim repost of post %d: %w", repost.PostId, err)
+	}
+	if !applied {
+		return existingRepost(repost.PostId, repost.UserId, existing), false, nil
+	}
+
+	payload, err := protojson.Marshal(repost)
+	if err == nil {
+		batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+		batch.Query(insertEntryQuery, repost.RepostId, repost.UserId, repost.CreatedAt.AsTime(), int32(postv1.Audience_AUDIENCE_PUBLIC), repost.PostId)
+		batch.Query(insertOutboxQuery, eventType, payload)
+		err = r.session.ExecuteBatch(batch)
+	}
+	if err != nil {
+		// Give the claim back so the user can retry.
+		if relErr := r.session.Query(releaseQuery, repost.PostId, repost.UserId).WithContext(ctx).Exec(); relErr != nil {
+			slog.Error("failed to release repost claim", "post_id", repost.PostId, "user_id", repost.UserId, "error", relErr)
+		}
+		return nil, false, fmt.Errorf("failed to execute repost batch: %w", err)
+	}
+
+	return repost, true, nil
+}
+
+// existingRepost reads the reposts_by_post row returned by a conditional insert that
+// did not apply, so a repeated repost returns the original one.
+func existingRepost(postId, userId int64, row map[string]interface{}) *postv1.Repost {
+	repostID, _ := row["repost_id"].(int64)
+	repostedAt, _ := row["reposted_at"].(time.Time)
+	return &postv1.Repost{
+		PostId:    postId,
+		UserId:    userId,
+		RepostId:  repostID,
+		CreatedAt: timestamppb.New(repostedAt),
+	}
+}
+
+// DeleteRepost removes the user's repost of a post, if any, together with its
+// posts_by_user entry. It reports whether a repost was removed.
+func (r *PostRepository) DeleteRepost(ctx context.Context, postId, userId int64) (bool, error) {
+	const (
+		selectQuery = `SELECT repost_id, reposted_at FROM threads_keyspace.reposts_by_post WHERE post_id = ? AND user_id = ?`
+
+		releaseQuery = `DELETE FROM threads_keyspace.reposts_by_post WHERE post_id = ? AND user_id = ? IF EXISTS`
+
+		restoreQuery = `INSERT INTO threads_keyspace.reposts_by_post (post_id, user_id, repost_id, reposted_at) VALUES (?, ?, ?, ?)`
+
+		deleteEntryQuery = `DELETE FROM threads_keyspace.posts_by_user WHERE user_id = ? AND post_id = ?`
+
+		insertOutboxQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (uuid(), ?, ?, false) USING TTL 86400`
+
+		eventType = "post.unreposted"
+	)
+
+	var (
+		repostID   int64
+		repostedAt time.Time
+	)
+	if err := r.session.Query(selectQuery, postId, userId).WithContext(ctx).Scan(&repostID, &repostedAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get repost of post %d: %w", postId, err)
+	}
+
+	payload, err := protojson.Marshal(&postv1.Repost{
+		PostId:    postId,
+		UserId:    userId,
+		RepostId:  repostID,
+		CreatedAt: timestamppb.New(repostedAt),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal repost for outbox: %w", err)
+	}
+
+	// Only the caller that actually removes the row emits the event, so concurrent
+	// undos decrement the counter once.
+	applied, err := r.session.Query(releaseQuery, postId, userId).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete repost of post %d: %w", postId, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(deleteEntryQuery, userId, repostID)
+	batch.Query(insertOutboxQuery, eventType, payload)
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		// Put the row back so a retry finds the repost again.
+		if resErr := r.session.Query(restoreQuery, postId, userId, repostID, repostedAt).WithContext(ctx).Exec(); resErr != nil {
+			slog.Error("failed to restore repost after undo failure", "post_id", postId, "user_id", userId, "error", resErr)
+		}
+		return false, fmt.Errorf("failed to execute undo repost batch: %w", err)
+	}
+
+	return true, nil
+}
+
+// LikedPostIDs reports which of postIds the user has liked, reading likes_by_post.
+func (r *PostRepository) LikedPostIDs(ctx context.Context, userId int64, postIds []int64) (map[int64]bool, error) {
+	return r.postIDsWithUser(ctx, `SELECT post_id FROM threads_keyspace.likes_by_post WHERE post_id IN ? AND user_id = ?`, userId, postIds)
+}
+
+// RepostedPostIDs reports which of postIds the user has reposted.
+func (r *PostRepository) RepostedPostIDs(ctx context.Context, userId int64, postIds []int64) (map[int64]bool, error) {
+	return r.postIDsWithUser(ctx, `SELECT post_id FROM threads_keyspace.reposts_by_post WHERE post_id IN ? AND user_id = ?`, userId, postIds)
+}
+
+// postIDsWithUser runs a (post_id IN ?, user_id = ?) lookup in chunks and collects the
+// post ids that have a row.
+func (r *PostRepository) postIDsWithUser(ctx context.Context, query string, userId int64, postIds []int64) (map[int64]bool, error) {
+	found := make(map[int64]bool)
+	for chunk := range database.ChunkIDs(postIds) {
+		iter := r.session.Query(query, chunk, userId).WithContext(ctx).Iter()
+
+		var postID int64
+		for iter.Scan(&postID) {
+			found[postID] = true
+		}
+
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to look up posts for user %d: %w", userId, err)
+		}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	postv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExistingLike(t *testing.T) {
+	likedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	eventID := gocql.TimeUUID()
+
+	tests := []struct {
+		name        string
+		row         map[string]interface{}
+		wantEventID gocql.UUID
+		wantPending bool
+	}{
+		{"event written", map[string]interface{}{"liked_at": likedAt, "event_id": eventID, "emitted": true}, eventID, false},
+		{"event not confirmed", map[string]interface{}{"liked_at": likedAt, "event_id": eventID, "emitted": false}, eventID, true},
+		{"emitted never set", map[string]interface{}{"liked_at": likedAt, "event_id": eventID}, eventID, true},
+		{"row from before event ids", map[string]interface{}{"liked_at": likedAt}, gocql.UUID{}, false},
+		{"null event id", map[string]interface{}{"liked_at": likedAt, "event_id": gocql.UUID{}, "emitted": false}, gocql.UUID{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			like, gotEventID, pending := existingLike(1, 2, tt.row)
+			if pending != tt.wantPending {
+				t.Errorf("pending = %v, want %v", pending, tt.wantPending)
+			}
+			if gotEventID != tt.wantEventID {
+				t.Errorf("event id = %v, want %v", gotEventID, tt.wantEventID)
+			}
+			if like.PostId != 1 || like.UserId != 2 || !like.CreatedAt.AsTime().Equal(likedAt) {
+				t.Errorf("like = %v, want post 1, user 2, liked at %v", like, likedAt)
+			}
+		})
+	}
+}
+
+func TestExistingRepost(t *testing.T) {
+	repostedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	repost := existingRepost(1, 2, map[string]interface{}{"repost_id": int64(99), "reposted_at": repostedAt})
+	if repost.PostId != 1 || repost.UserId != 2 || repost.RepostId != 99 || !repost.CreatedAt.AsTime().Equal(repostedAt) {
+		t.Errorf("repost = %v, want the stored repost 99 of post 1 by user 2 at %v", repost, repostedAt)
+	}
+}
+
+func TestUnlikeTimestamp(t *testing.T) {
+	likedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	unlikedAt := likedAt.Add(time.Minute)
+	now := likedAt.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		like *postv1.Like
+		want time.Time
+	}{
+		{"unlike time", &postv1.Like{CreatedAt: timestamppb.New(likedAt), DeletedAt: timestamppb.New(unlikedAt)}, unlikedAt},
+		{"event from before deleted_at", &postv1.Like{CreatedAt: timestamppb.New(likedAt)}, now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unlikeTimestamp(tt.like, now)
+			if got != tt.want.UnixMicro() {
+				t.Errorf("unlikeTimestamp() = %d, want %d", got, tt.want.UnixMicro())
+			}
+			// The unlike must win over a late like.created for the same like, which
+			// CreateUserLike writes at the like time.
+			if got <= tt.like.CreatedAt.AsTime().UnixMicro() {
+				t.Errorf("unlikeTimestamp() = %d, not after the like at %d", got, tt.like.CreatedAt.AsTime().UnixMicro())
+			}
+		})
+	}
+}
+
+func TestEngagementCounterColumns(t *testing.T) {
+	// Unknown columns are rejected before any query, so no session is needed.
+	r := &PostRepository{}
+	ctx := context.Background()
+
+	for _, column := range []string{"share_count", "view_count", ""} {
+		if err := r.SafeDecrementEngagementCounts(ctx, 1, column); err == nil {
+			t.Errorf("SafeDecrementEngagementCounts(%q) succeeded, want an error", column)
+		}
+	}
+	if err := r.SafeIncrementEngagementCounts(ctx, 1, "view_count"); err == nil {
+		t.Error(`SafeIncrementEngagementCounts("view_count") succeeded, want an error`)
+	}
+}
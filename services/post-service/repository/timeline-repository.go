@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/redis/go-redis/v9"
+	"github.com/yaninyzwitty/threads-go-backend/shared/database"
+	"golang.org/x/sync/errgroup"
+)
+
+// timelineWriteLimit bounds concurrent inserts while fanning a post out.
+const timelineWriteLimit = 32
+
+// ListFollowerIDs pages through the ids of users following userId. followers_by_user is
+// owned by the user-service and only read here.
+func (r *PostRepository) ListFollowerIDs(ctx context.Context, userId int64, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	return r.listUserIDs(ctx, `SELECT follower_id FROM threads_keyspace.followers_by_user WHERE user_id = ?`, userId, pageSize, pagingState)
+}
+
+// ListCloseFriendIDs pages through the ids on userId's close friends list.
+func (r *PostRepository) ListCloseFriendIDs(ctx context.Context, userId int64, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	return r.listUserIDs(ctx, `SELECT friend_id FROM threads_keyspace.close_friends_by_user WHERE user_id = ?`, userId, pageSize, pagingState)
+}
+
+func (r *PostRepository) listUserIDs(ctx context.Context, query string, userId int64, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	iter := r.session.Query(query, userId).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		ids []int64
+		id  int64
+	)
+	for iter.Scan(&id) {
+		ids = append(ids, id)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list users related to %d: %w", userId, err)
+	}
+
+	return ids, nextPageState, nil
+}
+
+// AddToHomeTimelines writes the post into each user's home timeline. Rows are upserts,
+// so repeating a fan-out is harmless.
+func (r *PostRepository) AddToHomeTimelines(ctx context.Context, userIds []int64, postId, authorId int64) error {
+	query := `INSERT INTO threads_keyspace.home_timeline_by_user (user_id, post_id, author_id) VALUES (?, ?, ?)`
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(timelineWriteLimit)
+	for _, userId := range userIds {
+		g.Go(func() error {
+			if err := r.session.Query(query, userId, postId, authorId).WithContext(gctx).Exec(); err != nil {
+				return fmt.Errorf("failed to add post %d to timeline of user %d: %w", postId, userId, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// RemoveFromHomeTimelines deletes the post from each user's home timeline.
+func (r *PostRepository) RemoveFromHomeTimelines(ctx context.Context, userIds []int64, postId int64) error {
+	query := `DELETE FROM threads_keyspace.home_timeline_by_user WHERE user_id = ? AND post_id = ?`
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(timelineWriteLimit)
+	for _, userId := range userIds {
+		g.Go(func() error {
+			if err := r.session.Query(query, userId, postId).WithContext(gctx).Exec(); err != nil {
+				return fmt.Errorf("failed to remove post %d from timeline of user %d: %w", postId, userId, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// AddPostsToHomeTimeline writes several posts by one author into a single user's timeline.
+func (r *PostRepository) AddPostsToHomeTimeline(ctx context.Context, userId, authorId int64, postIds []int64) error {
+	query := `INSERT INTO threads_keyspace.home_timeline_by_user (user_id, post_id, author_id) VALUES (?, ?, ?)`
+
+	if len(postIds) == 0 {
+		return nil
+	}
+
+	// One partition, so an unlogged batch is a single write.
+	batch := r.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, postId := range postIds {
+		batch.Query(query, userId, postId, authorId)
+	}
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to backfill timeline of user %d: %w", userId, err)
+	}
+	return nil
+}
+
+// RemoveFromHomeTimeline deletes the posts from the user's timeline.
+func (r *PostRepository) RemoveFromHomeTimeline(ctx context.Context, userId int64, postIds []int64) error {
+	query := `DELETE FROM threads_keyspace.home_timeline_by_user WHERE user_id = ? AND post_id IN ?`
+
+	if len(postIds) == 0 {
+		return nil
+	}
+
+	if err := r.session.Query(query, userId, postIds).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to prune timeline of user %d: %w", userId, err)
+	}
+	return nil
+}
+
+// ListHomeTimeline returns up to limit post ids from a user's timeline, newest first.
+// A non-zero beforeId only returns older posts; snowflake ids sort by time, so this is
+// the timeline cursor.
+func (r *PostRepository) ListHomeTimeline(ctx context.Context, userId, beforeId int64, limit int) ([]int64, error) {
+	query := `SELECT post_id FROM threads_keyspace.home_timeline_by_user WHERE user_id = ? LIMIT ?`
+	args := []interface{}{userId, limit}
+	if beforeId != 0 {
+		query = `SELECT post_id FROM threads_keyspace.home_timeline_by_user WHERE user_id = ? AND post_id < ? LIMIT ?`
+		args = []interface{}{userId, beforeId, limit}
+	}
+
+	iter := r.session.Query(query, args...).WithContext(ctx).Iter()
+
+	var (
+		postIDs []int64
+		postID  int64
+	)
+	for iter.Scan(&postID) {
+		postIDs = append(postIDs, postID)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list timeline of user %d: %w", userId, err)
+	}
+
+	return postIDs, nil
+}
+
+// GetFollowerCount reads the author's follower counter, 0 when there is no row.
+func (r *PostRepository) GetFollowerCount(ctx context.Context, userId int64) (int64, error) {
+	query := `SELECT follower_count FROM threads_keyspace.follower_counts WHERE user_id = ?`
+
+	var count int64
+	if err := r.session.Query(query, userId).WithContext(ctx).Scan(&count); err != nil {
+		if err == gocql.ErrNotFound {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get follower count for user %d: %w", userId, err)
+	}
+	return count, nil
+}
+
+// MarkFanOutSkipped records that a post by the author was not fanned out. Readers merge
+// the recent posts of every followed author with a row here, so the decision is taken
+// once at write time and later threshold changes need no backfill. Rows expire with the
+// timeline window.
+func (r *PostRepository) MarkFanOutSkipped(ctx context.Context, authorId int64, at time.Time) error {
+	query := `INSERT INTO threads_keyspace.fanout_skipped_authors (author_id, last_skipped_at) VALUES (?, ?)`
+
+	if err := r.session.Query(query, authorId, at).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to mark fan-out skipped for author %d: %w", authorId, err)
+	}
+
+	r.InvalidateRecentPostIDs(ctx, authorId)
+	return nil
+}
+
+// FanOutSkippedAuthors returns which of authorIds have posts that were not fanned out.
+func (r *PostRepository) FanOutSkippedAuthors(ctx context.Context, authorIds []int64) ([]int64, error) {
+	query := `SELECT author_id FROM threads_keyspace.fanout_skipped_authors WHERE author_id IN ?`
+
+	var skipped []int64
+	for chunk := range database.ChunkIDs(authorIds) {
+		iter := r.session.Query(query, chunk).WithContext(ctx).Iter()
+
+		var authorId int64
+		for iter.Scan(&authorId) {
+			skipped = append(skipped, authorId)
+		}
+
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to read fan-out skipped authors: %w", err)
+		}
+	}
+
+	return skipped, nil
+}
+
+// ListFollowingIDs pages through the ids userId follows.
+func (r *PostRepository) ListFollowingIDs(ctx context.Context, userId int64, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	return r.listUserIDs(ctx, `SELECT following_id FROM threads_keyspace.following_by_user WHERE user_id = ?`, userId, pageSize, pagingState)
+}
+
+func recentPostsCacheKey(authorId int64) string {
+	return fmt.Sprintf("posts:%d:recent", authorId)
+}
+
+func mergedAuthorsCacheKey(userId int64) string {
+	return fmt.Sprintf("user:%d:timeline_merge_authors", userId)
+}
+
+// GetCachedRecentPostIDs returns the cached recent post ids of an author. ok is false on
+// a miss; cache errors are logged and reported as misses.
+func (r *PostRepository) GetCachedRecentPostIDs(ctx context.Context, authorId int64) (ids []int64, ok bool) {
+	return r.getCachedIDs(ctx, recentPostsCacheKey(authorId))
+}
+
+func (r *PostRepository) CacheRecentPostIDs(ctx context.Context, authorId int64, ids []int64, ttl time.Duration) {
+	r.setCachedIDs(ctx, recentPostsCacheKey(authorId), ids, ttl)
+}
+
+// InvalidateRecentPostIDs drops the author's cached recent post ids; failures are logged.
+func (r *PostRepository) InvalidateRecentPostIDs(ctx context.Context, authorId int64) {
+	if r.cache == nil {
+		return
+	}
+	if err := r.cache.Del(ctx, recentPostsCacheKey(authorId)).Err(); err != nil {
+		slog.Warn("recent posts cache invalidation failed", "author_id", authorId, "error", err)
+	}
+}
+
+// GetCachedMergedAuthors returns the cached ids of followed authors whose posts the
+// user's timeline merges at read time.
+func (r *PostRepository) GetCachedMergedAuthors(ctx context.Context, userId int64) (ids []int64, ok bool) {
+	return r.getCachedIDs(ctx, mergedAuthorsCacheKey(userId))
+}
+
+func (r *PostRepository) CacheMergedAuthors(ctx context.Context, userId int64, ids []int64, ttl time.Duration) {
+	r.setCachedIDs(ctx, mergedAuthorsCacheKey(userId), ids, ttl)
+}
+
+// Id lists are cached as comma-separated decimals; an empty string is a cached empty list.
+func (r *PostRepository) getCachedIDs(ctx context.Context, key string) ([]int64, bool) {
+	if r.cache == nil {
+		return nil, false
+	}
+	raw, err := r.cache.Get(ctx, key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			slog.Warn("timeline cache read failed", "key", key, "error", err)
+		}
+		return nil, false
+	}
+	if raw == "" {
+		return []int64{}, true
+	}
+
+	parts := strings.Split(raw, ",")
+	ids := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
+func (r *PostRepository) setCachedIDs(ctx context.Context, key string, ids []int64, ttl time.Duration) {
+	if r.cache == nil {
+		return
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+
+	if err := r.cache.Set(ctx, key, strings.Join(parts, ","), ttl).Err(); err != nil {
+		slog.Warn("timeline cache fill failed", "key", key, "error", err)
+	}
+}
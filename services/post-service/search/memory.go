@@ -0,0 +1,226 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"sync"
+)
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+type memoryDoc struct {
+	authorID int64
+	hashtags []string
+	length   int // terms in the content
+	version  int64
+	terms    []string // distinct terms, to find the postings on removal
+}
+
+// MemoryIndex is an in-process inverted index for local development. It starts empty on
+// every restart, so the post-service backfills it from the posts table at startup, and
+// afterwards only sees the events its own consumer receives; it is not meant for more
+// than one post-service instance.
+type MemoryIndex struct {
+	mu          sync.RWMutex
+	docs        map[int64]*memoryDoc
+	postings    map[string]map[int64][]int // term -> post id -> positions, ascending
+	totalLength int
+}
+
+var _ Index = (*MemoryIndex)(nil)
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[int64]*memoryDoc),
+		postings: make(map[string]map[int64][]int),
+	}
+}
+
+func (m *MemoryIndex) Upsert(ctx context.Context, doc Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if old, ok := m.docs[doc.PostID]; ok {
+		if old.version > doc.Version {
+			return nil
+		}
+		m.remove(doc.PostID, old)
+	}
+
+	tokens := Tokenize(doc.Content)
+	stored := &memoryDoc{
+		authorID: doc.AuthorID,
+		hashtags: doc.Hashtags,
+		length:   len(tokens),
+		version:  doc.Version,
+	}
+	for pos, term := range tokens {
+		byDoc, ok := m.postings[term]
+		if !ok {
+			byDoc = make(map[int64][]int)
+			m.postings[term] = byDoc
+		}
+		if _, seen := byDoc[doc.PostID]; !seen {
+			stored.terms = append(stored.terms, term)
+		}
+		byDoc[doc.PostID] = append(byDoc[doc.PostID], pos)
+	}
+
+	m.docs[doc.PostID] = stored
+	m.totalLength += stored.length
+	return nil
+}
+
+func (m *MemoryIndex) Delete(ctx context.Context, postId int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if doc, ok := m.docs[postId]; ok {
+		m.remove(postId, doc)
+	}
+	return nil
+}
+
+// remove drops a document and its postings. The caller holds the write lock.
+func (m *MemoryIndex) remove(postId int64, doc *memoryDoc) {
+	for _, term := range doc.terms {
+		delete(m.postings[term], postId)
+		if len(m.postings[term]) == 0 {
+			delete(m.postings, term)
+		}
+	}
+	delete(m.docs, postId)
+	m.totalLength -= doc.length
+}
+
+func (m *MemoryIndex) Search(ctx context.Context, q Query) ([]Hit, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	required := slices.Clone(q.Terms)
+	for _, phrase := range q.Phrases {
+		required = append(required, phrase...)
+	}
+	slices.Sort(required)
+	required = slices.Compact(required)
+
+	var hits []Hit
+	for id := range m.candidates(required) {
+		doc := m.docs[id]
+		if !m.matches(id, doc, q) {
+			continue
+		}
+
+		hit := Hit{PostID: id}
+		if q.Sort == SortRelevance {
+			hit.Score = m.score(id, doc, required)
+		}
+		if q.After.PostID != 0 && !afterCursor(hit, q.After) {
+			continue
+		}
+		hits = append(hits, hit)
+	}
+
+	slices.SortFunc(hits, func(a, b Hit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.PostID, a.PostID)
+	})
+
+	if len(hits) > q.Limit {
+		return hits[:q.Limit], true, nil
+	}
+	return hits, false, nil
+}
+
+// candidates yields the ids of documents containing every term, or of all documents
+// when there are no terms. The rarest term's postings are walked.
+func (m *MemoryIndex) candidates(terms []string) func(yield func(int64) bool) {
+	return func(yield func(int64) bool) {
+		if len(terms) == 0 {
+			for id := range m.docs {
+				if !yield(id) {
+					return
+				}
+			}
+			return
+		}
+
+		rarest := slices.MinFunc(terms, func(a, b string) int {
+			return cmp.Compare(len(m.postings[a]), len(m.postings[b]))
+		})
+	next:
+		for id := range m.postings[rarest] {
+			for _, term := range terms {
+				if _, ok := m.postings[term][id]; !ok {
+					continue next
+				}
+			}
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+// matches applies the filters and phrases of q to a document that has all its terms.
+func (m *MemoryIndex) matches(id int64, doc *memoryDoc, q Query) bool {
+	if q.AuthorID != 0 && doc.authorID != q.AuthorID {
+		return false
+	}
+	if id < q.MinID || (q.MaxID != 0 && id >= q.MaxID) {
+		return false
+	}
+	if q.Hashtag != "" && !slices.Contains(doc.hashtags, q.Hashtag) {
+		return false
+	}
+
+phrases:
+	for _, phrase := range q.Phrases {
+		for _, start := range m.postings[phrase[0]][id] {
+			found := true
+			for k, term := range phrase[1:] {
+				if _, ok := slices.BinarySearch(m.postings[term][id], start+k+1); !ok {
+					found = false
+					break
+				}
+			}
+			if found {
+				continue phrases
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// score is the document's BM25 score for terms.
+func (m *MemoryIndex) score(id int64, doc *memoryDoc, terms []string) float64 {
+	n := float64(len(m.docs))
+	avgLength := float64(m.totalLength) / n
+
+	var score float64
+	for _, term := range terms {
+		withTerm := float64(len(m.postings[term]))
+		idf := math.Log(1 + (n-withTerm+0.5)/(withTerm+0.5))
+
+		tf := float64(len(m.postings[term][id]))
+		score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(doc.length)/max(avgLength, 1)))
+	}
+	return score
+}
+
+// afterCursor reports whether hit sorts below the cursor position.
+func afterCursor(hit Hit, cursor Cursor) bool {
+	if hit.Score != cursor.Score {
+		return hit.Score < cursor.Score
+	}
+	return hit.PostID < cursor.PostID
+}
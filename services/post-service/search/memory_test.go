@@ -0,0 +1,135 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func newTestIndex(t *testing.T, docs ...Document) *MemoryIndex {
+	t.Helper()
+	m := NewMemoryIndex()
+	for _, doc := range docs {
+		if err := m.Upsert(context.Background(), doc); err != nil {
+			t.Fatalf("Upsert(%d): %v", doc.PostID, err)
+		}
+	}
+	return m
+}
+
+func hitIDs(hits []Hit) []int64 {
+	ids := make([]int64, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.PostID
+	}
+	return ids
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	m := newTestIndex(t,
+		Document{PostID: 1, AuthorID: 10, Content: "go is a language for building simple reliable software"},
+		Document{PostID: 2, AuthorID: 20, Content: "go go go"},
+		Document{PostID: 3, AuthorID: 10, Content: "learning go today", Hashtags: []string{"go"}},
+		Document{PostID: 4, AuthorID: 20, Content: "rust today"},
+		Document{PostID: 5, AuthorID: 30, Content: "today go learning"},
+	)
+
+	tests := []struct {
+		name string
+		q    Query
+		want []int64
+	}{
+		{"term frequency first", Query{Terms: []string{"go"}, Limit: 10}, []int64{2, 5, 3, 1}},
+		{"every term must match", Query{Terms: []string{"go", "today"}, Limit: 10}, []int64{5, 3}},
+		{"phrase order matters", Query{Phrases: [][]string{{"learning", "go"}}, Limit: 10}, []int64{3}},
+		{"recency", Query{Terms: []string{"today"}, Sort: SortRecency, Limit: 10}, []int64{5, 4, 3}},
+		{"no terms matches everything", Query{Sort: SortRecency, Limit: 10}, []int64{5, 4, 3, 2, 1}},
+		{"author filter", Query{Terms: []string{"go"}, AuthorID: 10, Limit: 10}, []int64{3, 1}},
+		{"hashtag filter", Query{Terms: []string{"go"}, Hashtag: "go", Limit: 10}, []int64{3}},
+		{"id range", Query{Sort: SortRecency, MinID: 2, MaxID: 4, Limit: 10}, []int64{3, 2}},
+		{"no match", Query{Terms: []string{"python"}, Limit: 10}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, _, err := m.Search(context.Background(), tt.q)
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if got := hitIDs(hits); !slices.Equal(got, tt.want) {
+				t.Errorf("Search(%+v) = %v, want %v", tt.q, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryIndexPaging(t *testing.T) {
+	m := newTestIndex(t,
+		Document{PostID: 1, Content: "go"},
+		Document{PostID: 2, Content: "go go"},
+		Document{PostID: 3, Content: "go"},
+		Document{PostID: 4, Content: "go"},
+		Document{PostID: 5, Content: "go go"},
+	)
+
+	var got []int64
+	q := Query{Terms: []string{"go"}, Limit: 2}
+	for {
+		hits, more, err := m.Search(context.Background(), q)
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		got = append(got, hitIDs(hits)...)
+		if !more {
+			break
+		}
+		last := hits[len(hits)-1]
+		q.After = Cursor{Score: last.Score, PostID: last.PostID}
+	}
+
+	// Equal scores fall back to newest first.
+	if want := []int64{5, 2, 4, 3, 1}; !slices.Equal(got, want) {
+		t.Errorf("paged hits = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryIndexUpsertAndDelete(t *testing.T) {
+	ctx := context.Background()
+	m := newTestIndex(t, Document{PostID: 1, Content: "first draft", Version: 2})
+
+	search := func(term string) []int64 {
+		hits, _, err := m.Search(ctx, Query{Terms: []string{term}, Limit: 10})
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		return hitIDs(hits)
+	}
+
+	// A stale write doesn't replace a newer one.
+	if err := m.Upsert(ctx, Document{PostID: 1, Content: "stale copy", Version: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := search("stale"); len(got) != 0 {
+		t.Errorf("stale upsert was indexed: %v", got)
+	}
+
+	if err := m.Upsert(ctx, Document{PostID: 1, Content: "edited text", Version: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if got := search("draft"); len(got) != 0 {
+		t.Errorf("old content still matches after edit: %v", got)
+	}
+	if got := search("edited"); !slices.Equal(got, []int64{1}) {
+		t.Errorf("edited content = %v, want [1]", got)
+	}
+
+	if err := m.Delete(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := search("edited"); len(got) != 0 {
+		t.Errorf("deleted post still matches: %v", got)
+	}
+	if len(m.postings) != 0 || m.totalLength != 0 {
+		t.Errorf("index not empty after delete: %d terms, length %d", len(m.postings), m.totalLength)
+	}
+}
@@ -0,0 +1,88 @@
+// Package search is the full-text index of post content. Backends implement Index; the
+// post-service keeps it up to date from post events.
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yaninyzwitty/threads-go-backend/shared/tags"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Document is the searchable part of a post.
+type Document struct {
+	PostID   int64
+	AuthorID int64
+	Content  string
+	Hashtags []string
+	// Version orders writes of the same post (creation or last edit time in
+	// microseconds). An upsert older than the indexed version is ignored.
+	Version int64
+}
+
+// Sort is the order of search hits.
+type Sort int
+
+const (
+	SortRelevance Sort = iota // best match first, newest first among equal scores
+	SortRecency               // newest first
+)
+
+// Query is a parsed search. Every term and every phrase must match. Zero filter fields
+// don't restrict.
+type Query struct {
+	Terms   []string
+	Phrases [][]string // each phrase's terms must appear consecutively
+
+	AuthorID int64
+	Hashtag  string // normalized tag
+	MinID    int64  // inclusive; post ids order by creation time
+	MaxID    int64  // exclusive
+
+	Sort  Sort
+	After Cursor // continue below this hit; the zero cursor starts at the top
+	Limit int
+}
+
+// Cursor is the position of the last hit returned.
+type Cursor struct {
+	Score  float64
+	PostID int64
+}
+
+// Hit is a matching post.
+type Hit struct {
+	PostID int64
+	Score  float64 // 0 when sorting by recency
+}
+
+// Index stores documents and answers queries.
+type Index interface {
+	Upsert(ctx context.Context, doc Document) error
+	Delete(ctx context.Context, postId int64) error
+	// Search returns up to q.Limit hits after q.After and whether more follow.
+	Search(ctx context.Context, q Query) ([]Hit, bool, error)
+}
+
+// Tokenize splits text into index terms: NFKC, lower case, runs of the characters a
+// hashtag may contain. "#Go" and "go" are the same term.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(norm.NFKC.String(text)), func(r rune) bool {
+		return !tags.IsTagRune(r)
+	})
+}
+
+// ParseQuery splits a user query into single terms and double-quoted phrases. An
+// unterminated quote runs to the end of the query; a phrase of one term is a term.
+func ParseQuery(raw string) (terms []string, phrases [][]string) {
+	for i, part := range strings.Split(raw, `"`) {
+		tokens := Tokenize(part)
+		if i%2 == 1 && len(tokens) > 1 {
+			phrases = append(phrases, tokens)
+			continue
+		}
+		terms = append(terms, tokens...)
+	}
+	return terms, phrases
+}
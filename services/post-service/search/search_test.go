@@ -0,0 +1,59 @@
+package search
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"lower cased", "Hello World", []string{"hello", "world"}},
+		{"hashtag is a term", "#Go and go", []string{"go", "and", "go"}},
+		{"punctuation splits", "don't-stop, now!", []string{"don", "t", "stop", "now"}},
+		{"underscore kept", "snake_case", []string{"snake_case"}},
+		{"nfkc", "ＧＯ ｆａｓｔ", []string{"go", "fast"}},
+		{"non latin", "東京 タワー", []string{"東京", "タワー"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !slices.Equal(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		terms   []string
+		phrases [][]string
+	}{
+		{"empty", "", nil, nil},
+		{"terms", "go Rust", []string{"go", "rust"}, nil},
+		{"phrase", `"hello world"`, nil, [][]string{{"hello", "world"}}},
+		{"mixed", `go "hello world" rust`, []string{"go", "rust"}, [][]string{{"hello", "world"}}},
+		{"one word phrase is a term", `"go" rust`, []string{"go", "rust"}, nil},
+		{"unterminated quote", `go "hello world`, []string{"go"}, [][]string{{"hello", "world"}}},
+		{"empty quotes", `"" go`, []string{"go"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms, phrases := ParseQuery(tt.raw)
+			if !slices.Equal(terms, tt.terms) {
+				t.Errorf("ParseQuery(%q) terms = %q, want %q", tt.raw, terms, tt.terms)
+			}
+			if !slices.EqualFunc(phrases, tt.phrases, slices.Equal) {
+				t.Errorf("ParseQuery(%q) phrases = %q, want %q", tt.raw, phrases, tt.phrases)
+			}
+		})
+	}
+}
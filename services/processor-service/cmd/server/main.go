@@ -16,8 +16,10 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
 	"github.com/yaninyzwitty/threads-go-backend/gen/processor/v1/processorv1connect"
 	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/controller"
+	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/kafka"
 	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/repository"
 	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
 	"github.com/yaninyzwitty/threads-go-backend/shared/database"
@@ -52,6 +54,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	// trending counters live in redis
+	rdbOpts, err := redis.ParseURL(helpers.GetEnvOrDefault("REDIS_URL", ""))
+	if err != nil {
+		slog.Error("invalid REDIS_URL", "error", err)
+		os.Exit(1)
+	}
+	rdb := redis.NewClient(rdbOpts)
+	defer rdb.Close()
+
 	db := database.NewAstraDB()
 
 	astraCfg := database.AstraConfig{
@@ -91,6 +102,16 @@ func main() {
 
 	defer producer.Close()
 
+	// create kafka consumer for derived data (follow suggestions, trending)
+	kafkaReader := queue.NewKafkaReader(queue.Config{
+		Brokers:  kafkaConfig.Brokers,
+		Topic:    kafkaConfig.Topic,
+		GroupID:  cfg.ProcessorServer.GroupID,
+		Username: kafkaConfig.Username,
+		Password: kafkaConfig.Password,
+	})
+	defer kafkaReader.Close()
+
 	// start background worker pool
 	workerContext, workerCancel := context.WithCancel(context.Background())
 
@@ -100,7 +121,7 @@ func main() {
 	processorServiceAddr := fmt.Sprintf(":%d", cfg.ProcessorServer.Port)
 
 	// create repository
-	processorServiceRepo := repository.NewProcessorRepository(session, producer)
+	processorServiceRepo := repository.NewProcessorRepository(session, producer, rdb)
 
 	// create controller
 	processorServiceController := controller.NewProcessorController(processorServiceRepo)
@@ -138,6 +159,9 @@ func main() {
 		}
 	}()
 
+	// Start Kafka consumer
+	kafka.StartKafkaConsumer(workerContext, kafkaReader, processorServiceController)
+
 	go func() {
 
 		defer wg.Done()
@@ -5,11 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"sort"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	processorv1 "github.com/yaninyzwitty/threads-go-backend/gen/processor/v1"
 	"github.com/yaninyzwitty/threads-go-backend/gen/processor/v1/processorv1connect"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
 	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/repository"
+	"golang.org/x/sync/errgroup"
 )
 
 type ProcessorController struct {
@@ -58,3 +64,160 @@ func (c *ProcessorController) ProcessOutboxMessage(
 		ProcessedCount: int32(processed),
 	}), nil
 }
+
+// Tuning for follow suggestions. Fan-out is capped on both hops so one
+// heavy follower cannot make a single recompute unbounded.
+const (
+	maxFirstDegree        = 200
+	maxSecondDegreeFollow = 200
+	candidatePoolSize     = 200
+	maxFollowSuggestions  = 50
+	suggestionReadWorkers = 10
+
+	mutualWeight      = 1.0
+	recencyWeight     = 2.0
+	popularityWeight  = 0.25
+	followRecencyHalf = 30 * 24 * time.Hour
+)
+
+type suggestionCandidate struct {
+	userID  int64
+	mutual  int
+	recency float64 // sum of decayed weights of the friend -> candidate follows
+}
+
+// ---------------- Compute Follow Suggestions ------------------
+
+// ComputeFollowSuggestions ranks friends-of-friends for userID and replaces their stored
+// suggestions, returning how many were stored. It is applied from user.followed and
+// user.unfollowed events for the follower; it is not part of ProcessorService.
+func (c *ProcessorController) ComputeFollowSuggestions(ctx context.Context, userID int64) (int, error) {
+	if userID == 0 {
+		return 0, errors.New("user id is missing")
+	}
+
+	now := time.Now()
+
+	var (
+		following []repository.FollowEdge
+		blocked   map[int64]struct{}
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		following, err = c.repo.GetFollowing(gctx, userID, maxFirstDegree)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		blocked, err = c.repo.GetBlockedUserIDs(gctx, userID)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return 0, fmt.Errorf("failed to load social graph: %w", err)
+	}
+
+	excluded := make(map[int64]struct{}, len(following)+len(blocked)+1)
+	excluded[userID] = struct{}{}
+	for _, edge := range following {
+		excluded[edge.FollowingID] = struct{}{}
+	}
+	for id := range blocked {
+		excluded[id] = struct{}{}
+	}
+
+	// Friends-of-friends: every account followed by someone the user follows.
+	var (
+		mu         sync.Mutex
+		candidates = make(map[int64]*suggestionCandidate)
+	)
+
+	g, gctx = errgroup.WithContext(ctx)
+	g.SetLimit(suggestionReadWorkers)
+	for _, friend := range following {
+		g.Go(func() error {
+			edges, err := c.repo.GetFollowing(gctx, friend.FollowingID, maxSecondDegreeFollow)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, edge := range edges {
+				if _, skip := excluded[edge.FollowingID]; skip {
+					continue
+				}
+				cand, ok := candidates[edge.FollowingID]
+				if !ok {
+					cand = &suggestionCandidate{userID: edge.FollowingID}
+					candidates[edge.FollowingID] = cand
+				}
+				cand.mutual++
+				cand.recency += recencyDecay(now.Sub(edge.FollowingAt))
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, fmt.Errorf("failed to expand social graph: %w", err)
+	}
+
+	// Narrow to the strongest candidates before paying for follower count lookups.
+	pool := make([]*suggestionCandidate, 0, len(candidates))
+	for _, cand := range candidates {
+		pool = append(pool, cand)
+	}
+	sort.Slice(pool, func(i, j int) bool {
+		if pool[i].mutual != pool[j].mutual {
+			return pool[i].mutual > pool[j].mutual
+		}
+		return pool[i].recency > pool[j].recency
+	})
+	if len(pool) > candidatePoolSize {
+		pool = pool[:candidatePoolSize]
+	}
+
+	ids := make([]int64, len(pool))
+	for i, cand := range pool {
+		ids[i] = cand.userID
+	}
+
+	followerCounts, err := c.repo.GetFollowerCounts(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load follower counts: %w", err)
+	}
+
+	suggestions := make([]*userv1.FollowSuggestion, 0, len(pool))
+	for _, cand := range pool {
+		score := mutualWeight*float64(cand.mutual) +
+			recencyWeight*cand.recency +
+			popularityWeight*math.Log1p(float64(max(followerCounts[cand.userID], 0)))
+
+		suggestions = append(suggestions, &userv1.FollowSuggestion{
+			UserId:      cand.userID,
+			Score:       score,
+			MutualCount: int32(cand.mutual),
+		})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+	if len(suggestions) > maxFollowSuggestions {
+		suggestions = suggestions[:maxFollowSuggestions]
+	}
+
+	if err := c.repo.ReplaceFollowSuggestions(ctx, userID, suggestions, now); err != nil {
+		return 0, fmt.Errorf("failed to store follow suggestions: %w", err)
+	}
+
+	return len(suggestions), nil
+}
+
+// recencyDecay halves the weight of a follow every followRecencyHalf.
+func recencyDecay(age time.Duration) float64 {
+	if age < 0 {
+		age = 0
+	}
+	return math.Exp2(-float64(age) / float64(followRecencyHalf))
+}
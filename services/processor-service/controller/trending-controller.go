@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	processorv1 "github.com/yaninyzwitty/threads-go-backend/gen/processor/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/repository"
+)
+
+// Tuning for trending hashtags. Activity is counted in distinct users, so one account
+// posting a tag a hundred times counts once.
+const (
+	minTrendingAuthors = 3    // distinct authors in the recent window before a tag can trend
+	trendingLikeWeight = 0.25 // a distinct liker counts for this many authors
+)
+
+// ---------------- Trending ------------------
+
+// RecordTrendingActivity counts a post or like towards the trend scores of the post's
+// hashtags and rescores them, returning how many tags were updated. Close friends
+// posts, self-likes and activity older than the baseline window are ignored. It is
+// applied from post.created and like.created events, with the actor taken from the
+// event; it is not part of ProcessorService.
+func (c *ProcessorController) RecordTrendingActivity(
+	ctx context.Context,
+	postId, actorId int64,
+	activity processorv1.TrendingActivity,
+	occurredAt time.Time,
+) (int, error) {
+	if postId == 0 || actorId == 0 {
+		return 0, errors.New("post id and actor id are required")
+	}
+	if activity != processorv1.TrendingActivity_TRENDING_ACTIVITY_POST && activity != processorv1.TrendingActivity_TRENDING_ACTIVITY_LIKE {
+		return 0, errors.New("invalid activity")
+	}
+
+	now := time.Now()
+	at := occurredAt
+	if at.After(now) {
+		at = now
+	}
+	if now.Sub(at) > repository.TrendingBaselineWindow {
+		return 0, nil
+	}
+
+	post, err := c.repo.GetTrendingPost(ctx, postId)
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if len(post.Hashtags) == 0 || post.Audience == int32(postsv1.Audience_AUDIENCE_CLOSE_FRIENDS) {
+		return 0, nil
+	}
+	// Likes say a tag is hot only while its posts are fresh.
+	if activity == processorv1.TrendingActivity_TRENDING_ACTIVITY_LIKE &&
+		(actorId == post.AuthorID || now.Sub(post.CreatedAt) > repository.TrendingBaselineWindow) {
+		return 0, nil
+	}
+
+	if err := c.repo.AddTrendingActivity(ctx, post.Hashtags, activity, actorId, at); err != nil {
+		return 0, err
+	}
+
+	for _, tag := range post.Hashtags {
+		counts, err := c.repo.CountTrendingActivity(ctx, tag, now)
+		if err != nil {
+			return 0, err
+		}
+		if err := c.repo.SetTrendingScore(ctx, tag, trendScore(counts), now); err != nil {
+			return 0, fmt.Errorf("failed to update trending: %w", err)
+		}
+	}
+
+	return len(post.Hashtags), nil
+}
+
+// trendScore measures how far a tag's recent activity is above what its 24h baseline
+// predicts for one window, scaled by the square root of the expectation so small tags
+// need a proportionally larger jump. Tags with too few recent authors score 0.
+func trendScore(c repository.TrendingCounts) float64 {
+	if c.RecentAuthors < minTrendingAuthors {
+		return 0
+	}
+
+	recent := float64(c.RecentAuthors) + trendingLikeWeight*float64(c.RecentLikers)
+	baseline := float64(c.BaselineAuthors) + trendingLikeWeight*float64(c.BaselineLikers)
+	expected := baseline * float64(repository.TrendingRecentWindow) / float64(repository.TrendingBaselineWindow)
+
+	return (recent - expected) / math.Sqrt(expected+1)
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"math"
+	"testing"
+
+	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/repository"
+)
+
+func TestTrendScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts repository.TrendingCounts
+		want   float64
+	}{
+		{"no activity", repository.TrendingCounts{}, 0},
+		{"too few authors", repository.TrendingCounts{RecentAuthors: minTrendingAuthors - 1, RecentLikers: 100}, 0},
+		{"new tag", repository.TrendingCounts{RecentAuthors: 3, BaselineAuthors: 3}, (3 - 0.125) / math.Sqrt(1.125)},
+		{"likers weighted", repository.TrendingCounts{RecentAuthors: 4, RecentLikers: 4, BaselineAuthors: 48}, 3 / math.Sqrt(3)},
+		{"at baseline", repository.TrendingCounts{RecentAuthors: 3, BaselineAuthors: 72}, 0},
+		{"below baseline", repository.TrendingCounts{RecentAuthors: 3, BaselineAuthors: 240}, -7 / math.Sqrt(11)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trendScore(tt.counts); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("trendScore(%+v) = %v, want %v", tt.counts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrendScoreFavoursSmallTagSpikes(t *testing.T) {
+	// The same jump over baseline scores higher for a tag that is usually quiet.
+	small := trendScore(repository.TrendingCounts{RecentAuthors: 13, BaselineAuthors: 72})
+	large := trendScore(repository.TrendingCounts{RecentAuthors: 110, BaselineAuthors: 2400})
+	if small <= large {
+		t.Errorf("small tag score %v should be above large tag score %v", small, large)
+	}
+}
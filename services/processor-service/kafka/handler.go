@@ -0,0 +1,117 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+	postsv1 "github.com/yaninyzwitty/threads-go-backend/gen/posts/v1"
+	processorv1 "github.com/yaninyzwitty/threads-go-backend/gen/processor/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/services/processor-service/controller"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func StartKafkaConsumer(ctx context.Context, kafkaReader *kafka.Reader, processorController *controller.ProcessorController) {
+	slog.Info("starting kafka consumer...")
+	eventHandlers := map[string]func([]byte) error{
+		// The follower's graph changed either way, so their friends-of-friends ranking is stale.
+		"user.followed": func(b []byte) error {
+			var event userv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var followedEvent userv1.FollowedEvent
+			if err := protojson.Unmarshal([]byte(event.Payload), &followedEvent); err != nil {
+				return fmt.Errorf("failed to unmarshal FollowedEvent payload: %w", err)
+			}
+
+			_, err := processorController.ComputeFollowSuggestions(ctx, followedEvent.UserId)
+			return err
+		},
+		"user.unfollowed": func(b []byte) error {
+			var event userv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var unfollowedEvent userv1.UnfollowedEvent
+			if err := protojson.Unmarshal([]byte(event.Payload), &unfollowedEvent); err != nil {
+				return fmt.Errorf("failed to unmarshal UnfollowedEvent payload: %w", err)
+			}
+
+			_, err := processorController.ComputeFollowSuggestions(ctx, unfollowedEvent.UserId)
+			return err
+		},
+		// New posts and likes feed the trend counters of the post's hashtags.
+		"post.created": func(b []byte) error {
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var post postsv1.Post
+			if err := protojson.Unmarshal([]byte(event.Payload), &post); err != nil {
+				return fmt.Errorf("failed to unmarshal Post payload: %w", err)
+			}
+
+			_, err := processorController.RecordTrendingActivity(ctx, post.Id, post.User.GetId(),
+				processorv1.TrendingActivity_TRENDING_ACTIVITY_POST, post.CreatedAt.AsTime())
+			return err
+		},
+		"like.created": func(b []byte) error {
+			var event postsv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var like postsv1.Like
+			if err := protojson.Unmarshal([]byte(event.Payload), &like); err != nil {
+				return fmt.Errorf("failed to unmarshal Like payload: %w", err)
+			}
+
+			_, err := processorController.RecordTrendingActivity(ctx, like.PostId, like.UserId,
+				processorv1.TrendingActivity_TRENDING_ACTIVITY_LIKE, like.CreatedAt.AsTime())
+			return err
+		},
+	}
+
+	go func() {
+		for {
+			msg, err := kafkaReader.ReadMessage(ctx)
+			if ctx.Err() != nil {
+				slog.Info("Kafka consumer shutting down...")
+				return
+			}
+			if err != nil {
+				slog.Error("failed to read Kafka message", "error", err)
+				continue
+			}
+
+			parts := strings.Split(string(msg.Key), ":")
+			if len(parts) != 2 {
+				slog.Warn("invalid Kafka message key format", "key", string(msg.Key))
+				continue
+			}
+
+			// The topic carries every service's events; skip the ones we don't derive anything from.
+			eventKey := parts[0]
+			handler, ok := eventHandlers[eventKey]
+			if !ok {
+				continue
+			}
+
+			if err := handler(msg.Value); err != nil {
+				slog.Error("event handler failed", "key", eventKey, "error", err)
+				continue
+			}
+
+			if err := kafkaReader.CommitMessages(ctx, msg); err != nil {
+				slog.Error("failed to commit kafka message", "error", err)
+			}
+		}
+	}()
+}
@@ -3,9 +3,13 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/redis/go-redis/v9"
 	processorv1 "github.com/yaninyzwitty/threads-go-backend/gen/processor/v1"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/shared/database"
 	"github.com/yaninyzwitty/threads-go-backend/shared/queue"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -13,12 +17,14 @@ import (
 type ProcessorRepository struct {
 	session  *gocql.Session
 	producer *queue.Producer
+	cache    *redis.Client
 }
 
-func NewProcessorRepository(session *gocql.Session, producer *queue.Producer) *ProcessorRepository {
+func NewProcessorRepository(session *gocql.Session, producer *queue.Producer, cache *redis.Client) *ProcessorRepository {
 	return &ProcessorRepository{
 		session:  session,
 		producer: producer,
+		cache:    cache,
 	}
 }
 
@@ -75,3 +81,111 @@ func (r *ProcessorRepository) MarkEventAsPublished(ctx context.Context, eventId
 
 	return r.session.Query(query, eventId).WithContext(ctx).Exec()
 }
+
+// FollowEdge is a single row of following_by_user.
+type FollowEdge struct {
+	FollowingID int64
+	FollowingAt time.Time
+}
+
+func (r *ProcessorRepository) GetFollowing(ctx context.Context, userID int64, limit int) ([]FollowEdge, error) {
+	query := `
+		SELECT following_id, following_at
+		FROM threads_keyspace.following_by_user
+		WHERE user_id = ?
+		LIMIT ?`
+
+	iter := r.session.Query(query, userID, limit).WithContext(ctx).Iter()
+
+	var (
+		edges       []FollowEdge
+		followingID int64
+		followingAt time.Time
+	)
+
+	for iter.Scan(&followingID, &followingAt) {
+		edges = append(edges, FollowEdge{FollowingID: followingID, FollowingAt: followingAt})
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list following for user %d: %w", userID, err)
+	}
+
+	return edges, nil
+}
+
+// GetBlockedUserIDs returns every user that userID has blocked or been blocked by.
+func (r *ProcessorRepository) GetBlockedUserIDs(ctx context.Context, userID int64) (map[int64]struct{}, error) {
+	queries := []string{
+		`SELECT blocked_id FROM threads_keyspace.blocked_users WHERE user_id = ?`,
+		`SELECT blocker_id FROM threads_keyspace.blocked_by_users WHERE user_id = ?`,
+	}
+
+	blocked := make(map[int64]struct{})
+	for _, query := range queries {
+		iter := r.session.Query(query, userID).WithContext(ctx).Iter()
+
+		var id int64
+		for iter.Scan(&id) {
+			blocked[id] = struct{}{}
+		}
+
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to list blocks for user %d: %w", userID, err)
+		}
+	}
+
+	return blocked, nil
+}
+
+func (r *ProcessorRepository) GetFollowerCounts(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	query := `
+		SELECT user_id, follower_count
+		FROM threads_keyspace.follower_counts
+		WHERE user_id IN ?`
+
+	counts := make(map[int64]int64, len(userIDs))
+	for chunk := range database.ChunkIDs(userIDs) {
+		iter := r.session.Query(query, chunk).WithContext(ctx).Iter()
+
+		var userID, followerCount int64
+		for iter.Scan(&userID, &followerCount) {
+			counts[userID] = followerCount
+		}
+
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to fetch follower counts: %w", err)
+		}
+	}
+
+	return counts, nil
+}
+
+// ReplaceFollowSuggestions swaps the stored suggestions for userID with the given set.
+// Everything lives in the user's partition, so the delete and inserts apply atomically.
+func (r *ProcessorRepository) ReplaceFollowSuggestions(ctx context.Context, userID int64, suggestions []*userv1.FollowSuggestion, computedAt time.Time) error {
+	const (
+		deleteQuery = `DELETE FROM threads_keyspace.follow_suggestions_by_user USING TIMESTAMP ? WHERE user_id = ?`
+		insertQuery = `
+			INSERT INTO threads_keyspace.follow_suggestions_by_user
+			(user_id, score, suggested_id, mutual_count, computed_at)
+			VALUES (?, ?, ?, ?, ?)
+			USING TIMESTAMP ?`
+	)
+
+	// The delete must sort strictly before the inserts, otherwise Cassandra lets the tombstone win.
+	writeTime := computedAt.UnixMicro()
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(deleteQuery, writeTime-1, userID)
+
+	for _, s := range suggestions {
+		batch.Query(insertQuery, userID, s.Score, s.UserId, s.MutualCount, computedAt, writeTime)
+	}
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to replace follow suggestions for user %d: %w", userID, err)
+	}
+
+	return nil
+}
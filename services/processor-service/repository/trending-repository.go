@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/redis/go-redis/v9"
+	processorv1 "github.com/yaninyzwitty/threads-go-backend/gen/processor/v1"
+	"github.com/yaninyzwitty/threads-go-backend/shared/trending"
+)
+
+// ErrPostNotFound is returned when a post id has no row in the posts table.
+var ErrPostNotFound = errors.New("post not found")
+
+// Trend windows. Activity is counted in buckets, so the recent window covers between
+// 50 and 60 minutes depending on where in the current bucket we are.
+const (
+	TrendingRecentWindow   = time.Hour
+	TrendingBaselineWindow = 24 * time.Hour
+
+	recentBucketWidth   = 10 * time.Minute
+	baselineBucketWidth = time.Hour
+)
+
+// activeHashtagsKey is a sorted set of tag -> unix time of the tag's last activity, used
+// to drop tags from trending.HashtagsKey once they go quiet.
+const activeHashtagsKey = "trending:active"
+
+// TrendingPost is what trend counting needs to know about a post.
+type TrendingPost struct {
+	AuthorID  int64
+	Hashtags  []string
+	Audience  int32
+	CreatedAt time.Time
+}
+
+// GetTrendingPost reads a post's author, hashtags and audience from the post-service's
+// posts table.
+func (r *ProcessorRepository) GetTrendingPost(ctx context.Context, postID int64) (*TrendingPost, error) {
+	query := `SELECT user_id, hashtags, audience, created_at FROM threads_keyspace.posts WHERE post_id = ?`
+
+	var post TrendingPost
+	if err := r.session.Query(query, postID).WithContext(ctx).Scan(&post.AuthorID, &post.Hashtags, &post.Audience, &post.CreatedAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrPostNotFound
+		}
+		return nil, fmt.Errorf("failed to get post %d: %w", postID, err)
+	}
+	return &post, nil
+}
+
+// TrendingCounts are the distinct users active on a tag in the recent and baseline
+// windows. Baseline counts include the recent window.
+type TrendingCounts struct {
+	RecentAuthors   int64
+	BaselineAuthors int64
+	RecentLikers    int64
+	BaselineLikers  int64
+}
+
+// Activity keys are HyperLogLogs of user ids per tag, activity and bucket. The tag is
+// the key's hash tag so one PFCOUNT can union a tag's buckets on a cluster.
+func activityKey(tag string, activity processorv1.TrendingActivity, width time.Duration, bucket int64) string {
+	kind := "posts"
+	if activity == processorv1.TrendingActivity_TRENDING_ACTIVITY_LIKE {
+		kind = "likes"
+	}
+	return fmt.Sprintf("trending:{%s}:%s:%d:%d", tag, kind, int64(width/time.Second), bucket)
+}
+
+func bucketOf(t time.Time, width time.Duration) int64 {
+	return t.Unix() / int64(width/time.Second)
+}
+
+// bucketKeys returns the keys of the buckets covering window up to now.
+func bucketKeys(tag string, activity processorv1.TrendingActivity, width, window time.Duration, now time.Time) []string {
+	last := bucketOf(now, width)
+	n := int64(window / width)
+
+	keys := make([]string, 0, n)
+	for bucket := last - n + 1; bucket <= last; bucket++ {
+		keys = append(keys, activityKey(tag, activity, width, bucket))
+	}
+	return keys
+}
+
+// AddTrendingActivity counts userID as active on each tag in the buckets containing at.
+// Adding the same user again is a no-op, so redelivered events are harmless.
+func (r *ProcessorRepository) AddTrendingActivity(ctx context.Context, tags []string, activity processorv1.TrendingActivity, userID int64, at time.Time) error {
+	pipe := r.cache.Pipeline()
+	for _, tag := range tags {
+		for _, b := range []struct {
+			width, window time.Duration
+		}{
+			{recentBucketWidth, TrendingRecentWindow},
+			{baselineBucketWidth, TrendingBaselineWindow},
+		} {
+			bucket := bucketOf(at, b.width)
+			key := activityKey(tag, activity, b.width, bucket)
+			bucketEnd := time.Unix((bucket+1)*int64(b.width/time.Second), 0)
+
+			pipe.PFAdd(ctx, key, userID)
+			pipe.ExpireAt(ctx, key, bucketEnd.Add(b.window))
+		}
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record trending activity of user %d: %w", userID, err)
+	}
+	return nil
+}
+
+// CountTrendingActivity returns the distinct authors and likers of a tag's posts in
+// the windows ending at now.
+func (r *ProcessorRepository) CountTrendingActivity(ctx context.Context, tag string, now time.Time) (TrendingCounts, error) {
+	const (
+		posts = processorv1.TrendingActivity_TRENDING_ACTIVITY_POST
+		likes = processorv1.TrendingActivity_TRENDING_ACTIVITY_LIKE
+	)
+
+	pipe := r.cache.Pipeline()
+	recentAuthors := pipe.PFCount(ctx, bucketKeys(tag, posts, recentBucketWidth, TrendingRecentWindow, now)...)
+	baselineAuthors := pipe.PFCount(ctx, bucketKeys(tag, posts, baselineBucketWidth, TrendingBaselineWindow, now)...)
+	recentLikers := pipe.PFCount(ctx, bucketKeys(tag, likes, recentBucketWidth, TrendingRecentWindow, now)...)
+	baselineLikers := pipe.PFCount(ctx, bucketKeys(tag, likes, baselineBucketWidth, TrendingBaselineWindow, now)...)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return TrendingCounts{}, fmt.Errorf("failed to count trending activity of tag %q: %w", tag, err)
+	}
+
+	return TrendingCounts{
+		RecentAuthors:   recentAuthors.Val(),
+		BaselineAuthors: baselineAuthors.Val(),
+		RecentLikers:    recentLikers.Val(),
+		BaselineLikers:  baselineLikers.Val(),
+	}, nil
+}
+
+// SetTrendingScore stores a tag's score in trending.HashtagsKey, or removes the tag when
+// the score is not positive. Tags with no activity for TrendingRecentWindow are dropped
+// and the set is trimmed to trending.MaxHashtags.
+func (r *ProcessorRepository) SetTrendingScore(ctx context.Context, tag string, score float64, now time.Time) error {
+	staleBefore := strconv.FormatInt(now.Add(-TrendingRecentWindow).Unix(), 10)
+	stale, err := r.cache.ZRangeByScore(ctx, activeHashtagsKey, &redis.ZRangeBy{Min: "-inf", Max: "(" + staleBefore}).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list quiet trending tags: %w", err)
+	}
+
+	pipe := r.cache.TxPipeline()
+	if len(stale) > 0 {
+		members := make([]interface{}, len(stale))
+		for i, t := range stale {
+			members[i] = t
+		}
+		pipe.ZRem(ctx, trending.HashtagsKey, members...)
+		pipe.ZRem(ctx, activeHashtagsKey, members...)
+	}
+
+	pipe.ZAdd(ctx, activeHashtagsKey, redis.Z{Score: float64(now.Unix()), Member: tag})
+	if score > 0 {
+		pipe.ZAdd(ctx, trending.HashtagsKey, redis.Z{Score: score, Member: tag})
+		pipe.ZRemRangeByRank(ctx, trending.HashtagsKey, 0, -(trending.MaxHashtags + 1))
+	} else {
+		pipe.ZRem(ctx, trending.HashtagsKey, tag)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store trending score of tag %q: %w", tag, err)
+	}
+	return nil
+}
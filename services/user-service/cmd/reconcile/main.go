@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/reconcile"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/database"
+	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
+	"github.com/yaninyzwitty/threads-go-backend/shared/pkg"
+)
+
+// main runs a one-off repair of user-service derived data, e.g.
+//
+//	go run ./services/user-service/cmd/reconcile -job=follow-cache -dry-run
+//	go run ./services/user-service/cmd/reconcile -job=follower-counts -user-id=42
+//	go run ./services/user-service/cmd/reconcile -job=usernames
+func main() {
+	job := flag.String("job", "", "job to run: follow-cache, follower-counts, usernames")
+	dryRun := flag.Bool("dry-run", false, "report drift without writing")
+	pageSize := flag.Int("page-size", 500, "rows per page")
+	rate := flag.Int("rate", 10, "max pages (users for follower-counts) per second (0 = unlimited)")
+	userID := flag.Int64("user-id", 0, "follower-counts: repair a single user")
+	segments := flag.Int("segments", 16, "follower-counts: token ranges to scan the users table in")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		slog.Warn("No .env file found")
+	}
+
+	cfg := pkg.Config{}
+	if err := cfg.LoadConfig("config.yaml"); err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	rdbOpts, err := redis.ParseURL(helpers.GetEnvOrDefault("REDIS_URL", ""))
+	if err != nil {
+		slog.Error("invalid REDIS_URL", "error", err)
+		os.Exit(1)
+	}
+	rdb := redis.NewClient(rdbOpts)
+	defer rdb.Close()
+
+	sessionCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	dbSession, err := database.NewAstraDB().Connect(sessionCtx, &database.AstraConfig{
+		Username: cfg.Database.Username,
+		Path:     cfg.Database.Path,
+		Token:    helpers.GetEnvOrDefault("ASTRA_DB_TOKEN", ""),
+	}, 10*time.Second)
+	if err != nil {
+		slog.Error("failed to connect to astra db", "error", err)
+		os.Exit(1)
+	}
+	defer dbSession.Close()
+
+	userRepo := repository.NewUserRepository(dbSession, rdb)
+	opts := reconcile.Options{
+		DryRun:   *dryRun,
+		PageSize: *pageSize,
+		Rate:     *rate,
+		UserID:   *userID,
+		Segments: *segments,
+	}
+
+	switch *job {
+	case "follow-cache":
+		report, err := reconcile.FollowCache(ctx, userRepo, opts)
+		if err != nil {
+			slog.Error("follow cache reconciliation failed", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("follow cache reconciled",
+			"rows_scanned", report.RowsScanned,
+			"warmed", report.Warmed,
+			"keys_scanned", report.KeysScanned,
+			"stale_removed", report.StaleRemoved,
+			"dry_run", *dryRun)
+	case "follower-counts":
+		report, err := reconcile.FollowerCounts(ctx, userRepo, opts)
+		if err != nil {
+			slog.Error("follower count repair failed", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("follower counts repaired",
+			"users_checked", report.UsersChecked,
+			"users_drifted", report.UsersDrifted,
+			"corrected", report.Corrected,
+			"follower_skew", report.FollowerSkew,
+			"following_skew", report.FollowingSkew,
+			"dry_run", *dryRun)
+	case "usernames":
+		report, err := reconcile.Usernames(ctx, userRepo, opts)
+		if err != nil {
+			slog.Error("username backfill failed", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("usernames claimed",
+			"users_scanned", report.UsersScanned,
+			"claimed", report.Claimed,
+			"conflicts", report.Conflicts,
+			"dry_run", *dryRun)
+	default:
+		slog.Error("unknown job", "job", *job)
+		flag.Usage()
+		os.Exit(2)
+	}
+}
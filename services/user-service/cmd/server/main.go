@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -96,6 +97,22 @@ func main() {
 		Handler: h2c.NewHandler(mux, &http2.Server{}),
 	}
 
+	// User cache hit/miss counters are served on their own internal listener, never on
+	// the public port.
+	var debugServer *http.Server
+	if cfg.UserServer.DebugAddr != "" {
+		debugMux := http.NewServeMux()
+		debugMux.Handle("/debug/vars", expvar.Handler())
+		debugServer = &http.Server{Addr: cfg.UserServer.DebugAddr, Handler: debugMux}
+
+		go func() {
+			slog.Info("starting debug server", "address", debugServer.Addr)
+			if err := debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("debug server failed", "error", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -104,6 +121,11 @@ func main() {
 		slog.Info("received shutdown signal", "signal", sig)
 		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer shutdownCancel()
+		if debugServer != nil {
+			if err := debugServer.Shutdown(shutdownCtx); err != nil {
+				slog.Error("debug server forced to shutdown", "error", err)
+			}
+		}
 		if err := server.Shutdown(shutdownCtx); err != nil {
 			slog.Error("server forced to shutdown", "error", err)
 		} else {
@@ -4,18 +4,43 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"connectrpc.com/connect"
+	"github.com/gocql/gocql"
 	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
 	"github.com/yaninyzwitty/threads-go-backend/services/user-service/auth"
 	"github.com/yaninyzwitty/threads-go-backend/services/user-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/entities"
 	"github.com/yaninyzwitty/threads-go-backend/shared/snowflake"
+	"github.com/yaninyzwitty/threads-go-backend/shared/tags"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	defaultSuggestionLimit = 20
+	maxSuggestionLimit     = 50
+	maxBatchGetUsers       = 100
+	maxResolveUsernames    = 50
+	resolveReadLimit       = 16 // concurrent username lookups per ResolveUsernames call
+	maxTagsPerUser         = 10
+	maxTagPageSize         = 100
+	maxCloseFriendPageSize = 100
+
+	maxBioLength      = 160
+	maxProfileLinks   = 5
+	maxLinkLength     = 200
+	maxPronounsLength = 30
+	maxLocationLength = 60
+)
+
 type UserController struct {
 	userRepo *repository.UserRepository
 	store    auth.RefreshTokenStore
@@ -39,6 +64,11 @@ func (c *UserController) CreateUser(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
 	}
 
+	links, err := validateProfileFields(req.Msg.Bio, req.Msg.Links, req.Msg.Pronouns, req.Msg.Location, req.Msg.BannerUrl)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Msg.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to hash password: %w", err))
@@ -56,12 +86,24 @@ func (c *UserController) CreateUser(
 		Email:         req.Msg.Email,
 		ProfilePicUrl: req.Msg.ProfilePicUrl,
 		IsVerified:    req.Msg.IsVerified,
-		Password:      string(hashedPassword),
 		CreatedAt:     timestamppb.Now(),
 		UpdatedAt:     timestamppb.Now(),
+		Bio:           strings.TrimSpace(req.Msg.Bio),
+		Links:         links,
+		Pronouns:      strings.TrimSpace(req.Msg.Pronouns),
+		Location:      strings.TrimSpace(req.Msg.Location),
+		BannerUrl:     req.Msg.BannerUrl,
 	}
 
-	if err := c.userRepo.CreateUserWithInitialCounts(ctx, user); err != nil {
+	if err := c.userRepo.ClaimUsername(ctx, user.Username, user.Id); err != nil {
+		if errors.Is(err, repository.ErrUsernameTaken) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := c.userRepo.CreateUserWithInitialCounts(ctx, user, string(hashedPassword)); err != nil {
+		c.releaseUsername(ctx, user.Username, user.Id)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create user: %w", err))
 	}
 
@@ -78,12 +120,12 @@ func (c *UserController) LoginUser(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
 	}
 
-	user, err := c.userRepo.GetUserByEmail(ctx, req.Msg.Email)
+	user, passwordHash, err := c.userRepo.GetUserByEmail(ctx, req.Msg.Email)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user: %w", err))
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Msg.Password)); err != nil {
+	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(req.Msg.Password)); err != nil {
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
 	}
 
@@ -114,20 +156,49 @@ func (c *UserController) UpdateUser(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
 	}
 
+	links, err := validateProfileFields(req.Msg.Bio, req.Msg.Links, req.Msg.Pronouns, req.Msg.Location, req.Msg.BannerUrl)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	user, err := c.userRepo.GetUserByID(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user: %w", err))
 	}
 
+	// A new handle is claimed before the update and the old one released after it;
+	// a change of case only keeps the same claim.
+	previousUsername := user.Username
+	renamed := !strings.EqualFold(previousUsername, req.Msg.Username)
+	if renamed {
+		if err := c.userRepo.ClaimUsername(ctx, req.Msg.Username, user.Id); err != nil {
+			if errors.Is(err, repository.ErrUsernameTaken) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, err)
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
 	user.Username = req.Msg.Username
 	user.FullName = req.Msg.FullName
 	user.Email = req.Msg.Email
 	user.ProfilePicUrl = req.Msg.ProfilePicUrl
+	user.Bio = strings.TrimSpace(req.Msg.Bio)
+	user.Links = links
+	user.Pronouns = strings.TrimSpace(req.Msg.Pronouns)
+	user.Location = strings.TrimSpace(req.Msg.Location)
+	user.BannerUrl = req.Msg.BannerUrl
 	user.UpdatedAt = timestamppb.Now()
 
 	if err := c.userRepo.UpdateUser(ctx, user); err != nil {
+		if renamed {
+			c.releaseUsername(ctx, req.Msg.Username, user.Id)
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update user: %w", err))
 	}
+	if renamed {
+		c.releaseUsername(ctx, previousUsername, user.Id)
+	}
 
 	return connect.NewResponse(&userv1.UpdateUserResponse{User: user}), nil
 }
@@ -151,13 +222,27 @@ func (c *UserController) DeleteUser(
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("unauthorized deletion"))
 	}
 
-	if err := c.userRepo.DeleteUser(ctx, req.Msg.Id); err != nil {
+	deleted, err := c.userRepo.GetUserByID(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user: %w", err))
+	}
+
+	if err := c.userRepo.DeleteUser(ctx, req.Msg.Id, time.Now()); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete user: %w", err))
 	}
+	c.releaseUsername(ctx, deleted.Username, deleted.Id)
 
 	return connect.NewResponse(&userv1.DeleteUserResponse{Success: true}), nil
 }
 
+// releaseUsername frees a handle the user no longer holds. A failure leaves the name
+// claimed, which only keeps it unavailable, so it is logged rather than returned.
+func (c *UserController) releaseUsername(ctx context.Context, username string, userID int64) {
+	if err := c.userRepo.ReleaseUsername(ctx, username, userID); err != nil {
+		slog.Error("failed to release username", "username", username, "user_id", userID, "error", err)
+	}
+}
+
 // ---------------- Get User By ID ------------------
 func (c *UserController) GetUserByID(
 	ctx context.Context,
@@ -176,6 +261,388 @@ func (c *UserController) GetUserByID(
 	return connect.NewResponse(&userv1.GetUserByIDResponse{User: user}), nil
 }
 
+// ---------------- Add Close Friend ------------------
+func (c *UserController) AddCloseFriend(
+	ctx context.Context,
+	req *connect.Request[userv1.AddCloseFriendRequest],
+) (*connect.Response[userv1.AddCloseFriendResponse], error) {
+
+	if req.Msg.FriendId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	if user.Id == req.Msg.FriendId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot add yourself to close friends"))
+	}
+
+	if _, err := c.userRepo.GetUserByID(ctx, req.Msg.FriendId); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user: %w", err))
+	}
+
+	if err := c.userRepo.AddCloseFriend(ctx, user.Id, req.Msg.FriendId, time.Now()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add close friend: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.AddCloseFriendResponse{Success: true}), nil
+}
+
+// ---------------- Remove Close Friend ------------------
+func (c *UserController) RemoveCloseFriend(
+	ctx context.Context,
+	req *connect.Request[userv1.RemoveCloseFriendRequest],
+) (*connect.Response[userv1.RemoveCloseFriendResponse], error) {
+
+	if req.Msg.FriendId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	if err := c.userRepo.RemoveCloseFriend(ctx, user.Id, req.Msg.FriendId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove close friend: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.RemoveCloseFriendResponse{Success: true}), nil
+}
+
+// ---------------- List Close Friends ------------------
+// Always lists the caller's own list; there is deliberately no way to ask whose list
+// someone is on.
+func (c *UserController) ListCloseFriends(
+	ctx context.Context,
+	req *connect.Request[userv1.ListCloseFriendsRequest],
+) (*connect.Response[userv1.ListCloseFriendsResponse], error) {
+
+	if req.Msg.PageSize <= 0 || req.Msg.PageSize > maxCloseFriendPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxCloseFriendPageSize))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	friendIDs, nextPageToken, err := c.userRepo.ListCloseFriendIDs(ctx, user.Id, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list close friends: %w", err))
+	}
+
+	found, err := c.userRepo.BatchGetUsers(ctx, friendIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get close friends: %w", err))
+	}
+
+	users := make([]*userv1.User, 0, len(found))
+	for _, id := range friendIDs {
+		if friend, ok := found[id]; ok {
+			users = append(users, friend)
+		}
+	}
+
+	return connect.NewResponse(&userv1.ListCloseFriendsResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// ---------------- Set User Tags ------------------
+func (c *UserController) SetUserTags(
+	ctx context.Context,
+	req *connect.Request[userv1.SetUserTagsRequest],
+) (*connect.Response[userv1.SetUserTagsResponse], error) {
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	seen := make(map[string]struct{}, len(req.Msg.Tags))
+	normalized := make([]string, 0, len(req.Msg.Tags))
+	for _, raw := range req.Msg.Tags {
+		tag, err := tags.Normalize(raw)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if _, dup := seen[tag]; dup {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) > maxTagsPerUser {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d tags per user", maxTagsPerUser))
+	}
+
+	if err := c.userRepo.ReplaceUserTags(ctx, user.Id, normalized, time.Now()); err != nil {
+		if errors.Is(err, repository.ErrUserTagsChanged) {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set user tags: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.SetUserTagsResponse{Tags: normalized}), nil
+}
+
+// ---------------- Get User Tags ------------------
+func (c *UserController) GetUserTags(
+	ctx context.Context,
+	req *connect.Request[userv1.GetUserTagsRequest],
+) (*connect.Response[userv1.GetUserTagsResponse], error) {
+
+	if req.Msg.UserId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	}
+
+	userTags, err := c.userRepo.GetUserTags(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user tags: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.GetUserTagsResponse{Tags: userTags}), nil
+}
+
+// ---------------- List Users By Tag ------------------
+func (c *UserController) ListUsersByTag(
+	ctx context.Context,
+	req *connect.Request[userv1.ListUsersByTagRequest],
+) (*connect.Response[userv1.ListUsersByTagResponse], error) {
+
+	if req.Msg.PageSize <= 0 || req.Msg.PageSize > maxTagPageSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxTagPageSize))
+	}
+
+	tag, err := tags.Normalize(req.Msg.Tag)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userIDs, nextPageToken, err := c.userRepo.ListUserIDsByTag(ctx, tag, int(req.Msg.PageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list users by tag: %w", err))
+	}
+
+	found, err := c.userRepo.BatchGetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get tagged users: %w", err))
+	}
+
+	users := make([]*userv1.User, 0, len(found))
+	for _, id := range userIDs {
+		if user, ok := found[id]; ok {
+			users = append(users, user)
+		}
+	}
+
+	return connect.NewResponse(&userv1.ListUsersByTagResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// ---------------- Batch Get Users ------------------
+func (c *UserController) BatchGetUsers(
+	ctx context.Context,
+	req *connect.Request[userv1.BatchGetUsersRequest],
+) (*connect.Response[userv1.BatchGetUsersResponse], error) {
+
+	if len(req.Msg.Ids) > maxBatchGetUsers {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d ids per request", maxBatchGetUsers))
+	}
+
+	ids := distinctIDs(req.Msg.Ids)
+
+	found, err := c.userRepo.BatchGetUsers(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to batch get users: %w", err))
+	}
+
+	users := make([]*userv1.User, 0, len(found))
+	for _, id := range ids {
+		if user, ok := found[id]; ok {
+			users = append(users, user)
+		}
+	}
+
+	return connect.NewResponse(&userv1.BatchGetUsersResponse{Users: users}), nil
+}
+
+// distinctIDs drops zero and repeated ids, keeping the caller's order for the response.
+func distinctIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	distinct := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		distinct = append(distinct, id)
+	}
+	return distinct
+}
+
+// ---------------- Resolve Usernames ------------------
+func (c *UserController) ResolveUsernames(
+	ctx context.Context,
+	req *connect.Request[userv1.ResolveUsernamesRequest],
+) (*connect.Response[userv1.ResolveUsernamesResponse], error) {
+
+	if len(req.Msg.Usernames) > maxResolveUsernames {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d usernames per request", maxResolveUsernames))
+	}
+
+	var mu sync.Mutex
+	userIDs := make(map[string]int64, len(req.Msg.Usernames))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(resolveReadLimit)
+	seen := make(map[string]struct{}, len(req.Msg.Usernames))
+	for _, username := range req.Msg.Usernames {
+		if _, dup := seen[username]; dup || username == "" {
+			continue
+		}
+		seen[username] = struct{}{}
+
+		g.Go(func() error {
+			id, err := c.userRepo.GetUserIDByUsername(gctx, username)
+			if errors.Is(err, gocql.ErrNotFound) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to resolve username %q: %w", username, err)
+			}
+
+			mu.Lock()
+			userIDs[username] = id
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.ResolveUsernamesResponse{UserIds: userIDs}), nil
+}
+
+// ---------------- Get User Profile ------------------
+func (c *UserController) GetUserProfile(
+	ctx context.Context,
+	req *connect.Request[userv1.GetUserProfileRequest],
+) (*connect.Response[userv1.GetUserProfileResponse], error) {
+
+	if req.Msg.UserId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	}
+
+	viewer, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	var (
+		user                       *userv1.User
+		followers, following       int64
+		postCount                  int64
+		relationship               = &userv1.Relationship{IsSelf: viewer.Id == req.Msg.UserId}
+		viewerFollows, followsBack bool
+		blocking, blockedBy        bool
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		user, err = c.userRepo.GetUserByID(gctx, req.Msg.UserId)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		followers, following, err = c.userRepo.GetFollowerCounts(gctx, req.Msg.UserId)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		postCount, err = c.userRepo.GetPostCount(gctx, req.Msg.UserId)
+		return err
+	})
+
+	if !relationship.IsSelf {
+		g.Go(func() error {
+			var err error
+			viewerFollows, err = c.userRepo.HasFollowRelation(gctx, viewer.Id, req.Msg.UserId)
+			return err
+		})
+		g.Go(func() error {
+			var err error
+			followsBack, err = c.userRepo.HasFollowRelation(gctx, req.Msg.UserId, viewer.Id)
+			return err
+		})
+		g.Go(func() error {
+			var err error
+			blocking, err = c.userRepo.HasBlocked(gctx, viewer.Id, req.Msg.UserId)
+			return err
+		})
+		g.Go(func() error {
+			var err error
+			blockedBy, err = c.userRepo.HasBlocked(gctx, req.Msg.UserId, viewer.Id)
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user profile: %w", err))
+	}
+
+	relationship.Following = viewerFollows
+	relationship.FollowedBy = followsBack
+	relationship.Blocking = blocking
+	relationship.BlockedBy = blockedBy
+
+	return connect.NewResponse(&userv1.GetUserProfileResponse{
+		Profile: userProfile(user, followers, following, postCount, relationship),
+	}), nil
+}
+
+// userProfile builds the public profile of user. Counters that drifted below zero are
+// shown as 0 until the repair job fixes them.
+func userProfile(user *userv1.User, followers, following, posts int64, relationship *userv1.Relationship) *userv1.UserProfile {
+	return &userv1.UserProfile{
+		Id:                 user.Id,
+		Username:           user.Username,
+		FullName:           user.FullName,
+		ProfilePicUrl:      user.ProfilePicUrl,
+		IsVerified:         user.IsVerified,
+		CreatedAt:          user.CreatedAt,
+		FollowerCount:      max(followers, 0),
+		FollowingCount:     max(following, 0),
+		PostCount:          max(posts, 0),
+		ViewerRelationship: relationship,
+		Bio:                user.Bio,
+		BioEntities:        entities.ToProto(entities.Parse(user.Bio)),
+		Links:              user.Links,
+		Pronouns:           user.Pronouns,
+		Location:           user.Location,
+		BannerUrl:          user.BannerUrl,
+	}
+}
+
 // ---------------- List Users ------------------
 func (c *UserController) ListUsers(
 	ctx context.Context,
@@ -216,6 +683,16 @@ func (c *UserController) FollowUser(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot follow yourself"))
 	}
 
+	for _, pair := range [][2]int64{{user.Id, req.Msg.FollowingId}, {req.Msg.FollowingId, user.Id}} {
+		blocked, err := c.userRepo.HasBlocked(ctx, pair[0], pair[1])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check blocks: %w", err))
+		}
+		if blocked {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("cannot follow a blocked user"))
+		}
+	}
+
 	if err := c.userRepo.SaveFollowRelationAndEmitEvent(ctx, user.Id, req.Msg.FollowingId, time.Now()); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to follow user: %w", err))
 	}
@@ -249,6 +726,82 @@ func (c *UserController) UnfollowUser(
 	return connect.NewResponse(&userv1.UnfollowUserResponse{Success: true}), nil
 }
 
+// ---------------- Block User ------------------
+// Blocking also removes any follow between the two users, in either direction, and
+// takes the blocked user off the caller's close friends.
+func (c *UserController) BlockUser(
+	ctx context.Context,
+	req *connect.Request[userv1.BlockUserRequest],
+) (*connect.Response[userv1.BlockUserResponse], error) {
+
+	if req.Msg.BlockedId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	if user.Id == req.Msg.BlockedId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot block yourself"))
+	}
+
+	if _, err := c.userRepo.GetUserByID(ctx, req.Msg.BlockedId); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user: %w", err))
+	}
+
+	now := time.Now()
+	if err := c.userRepo.BlockUser(ctx, user.Id, req.Msg.BlockedId, now); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to block user: %w", err))
+	}
+
+	// The block is written first, so a follow racing with it is refused or removed here.
+	for _, pair := range [][2]int64{{user.Id, req.Msg.BlockedId}, {req.Msg.BlockedId, user.Id}} {
+		following, err := c.userRepo.HasFollowRelation(ctx, pair[0], pair[1])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check follow: %w", err))
+		}
+		if !following {
+			continue
+		}
+		if err := c.userRepo.UnfollowUser(ctx, pair[0], pair[1], now); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove follow: %w", err))
+		}
+	}
+
+	if err := c.userRepo.RemoveCloseFriend(ctx, user.Id, req.Msg.BlockedId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove close friend: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.BlockUserResponse{Success: true}), nil
+}
+
+// ---------------- Unblock User ------------------
+func (c *UserController) UnblockUser(
+	ctx context.Context,
+	req *connect.Request[userv1.UnblockUserRequest],
+) (*connect.Response[userv1.UnblockUserResponse], error) {
+
+	if req.Msg.BlockedId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	if err := c.userRepo.UnblockUser(ctx, user.Id, req.Msg.BlockedId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to unblock user: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.UnblockUserResponse{Success: true}), nil
+}
+
 // ---------------- Refresh Token ------------------
 func (c *UserController) RefreshToken(
 	ctx context.Context,
@@ -399,3 +952,114 @@ func (c *UserController) InsertFollowerCounts(
 	}), nil
 
 }
+
+// ---------------- Invalidate User Cache ------------------
+func (c *UserController) InvalidateUserCache(
+	ctx context.Context,
+	req *connect.Request[userv1.InvalidateUserCacheRequest],
+) (*connect.Response[userv1.InvalidateUserCacheResponse], error) {
+
+	if req.Msg.UserId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user id is missing"))
+	}
+
+	if err := c.userRepo.InvalidateCachedUser(ctx, req.Msg.UserId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to invalidate user cache: %w", err))
+	}
+
+	return connect.NewResponse(&userv1.InvalidateUserCacheResponse{Success: true}), nil
+}
+
+// ---------------- Suggest Users To Follow ------------------
+func (c *UserController) SuggestUsersToFollow(
+	ctx context.Context,
+	req *connect.Request[userv1.SuggestUsersToFollowRequest],
+) (*connect.Response[userv1.SuggestUsersToFollowResponse], error) {
+
+	if req.Msg.Limit < 0 || req.Msg.Limit > maxSuggestionLimit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be between 0 and %d, 0 meaning %d", maxSuggestionLimit, defaultSuggestionLimit))
+	}
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultSuggestionLimit
+	}
+
+	user, err := auth.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	// Suggestions are precomputed by the processor-service; this is a single partition read.
+	suggestions, computedAt, err := c.userRepo.GetFollowSuggestions(ctx, user.Id, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get follow suggestions: %w", err))
+	}
+
+	resp := &userv1.SuggestUsersToFollowResponse{Suggestions: suggestions}
+	if !computedAt.IsZero() {
+		resp.ComputedAt = timestamppb.New(computedAt)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// validateProfileFields checks the free-form profile fields and returns the links
+// trimmed and de-duplicated.
+func validateProfileFields(bio string, links []string, pronouns, location, bannerURL string) ([]string, error) {
+	if utf8.RuneCountInString(strings.TrimSpace(bio)) > maxBioLength {
+		return nil, fmt.Errorf("bio must be at most %d characters", maxBioLength)
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(pronouns)) > maxPronounsLength {
+		return nil, fmt.Errorf("pronouns must be at most %d characters", maxPronounsLength)
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(location)) > maxLocationLength {
+		return nil, fmt.Errorf("location must be at most %d characters", maxLocationLength)
+	}
+	if bannerURL != "" {
+		if err := validateWebURL(bannerURL); err != nil {
+			return nil, fmt.Errorf("invalid banner url: %w", err)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(links))
+	cleaned := make([]string, 0, len(links))
+	for _, link := range links {
+		link = strings.TrimSpace(link)
+		if link == "" {
+			continue
+		}
+		if _, dup := seen[link]; dup {
+			continue
+		}
+		if err := validateWebURL(link); err != nil {
+			return nil, fmt.Errorf("invalid link %q: %w", link, err)
+		}
+		seen[link] = struct{}{}
+		cleaned = append(cleaned, link)
+	}
+	if len(cleaned) > maxProfileLinks {
+		return nil, fmt.Errorf("at most %d links allowed", maxProfileLinks)
+	}
+
+	return cleaned, nil
+}
+
+func validateWebURL(raw string) error {
+	if len(raw) > maxLinkLength {
+		return fmt.Errorf("longer than %d characters", maxLinkLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("must be an http or https url")
+	}
+	if u.Hostname() == "" || !strings.Contains(u.Hostname(), ".") {
+		return errors.New("must have a valid host")
+	}
+	if u.User != nil {
+		return errors.New("must not contain credentials")
+	}
+	return nil
+}
@@ -100,6 +100,44 @@ func StartKafkaConsumer(ctx context.Context, kafkaReader *kafka.Reader, userCont
 			)
 			return err
 		},
+
+		"user.updated": func(b []byte) error {
+			var event userv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var updatedEvent userv1.User
+			if err := protojson.Unmarshal([]byte(event.Payload), &updatedEvent); err != nil {
+				return fmt.Errorf("failed to unmarshal User payload: %w", err)
+			}
+
+			_, err := userController.InvalidateUserCache(ctx,
+				connect.NewRequest(&userv1.InvalidateUserCacheRequest{
+					UserId: updatedEvent.Id,
+				}),
+			)
+			return err
+		},
+
+		"user.deleted": func(b []byte) error {
+			var event userv1.OutboxEvent
+			if err := protojson.Unmarshal(b, &event); err != nil {
+				return fmt.Errorf("failed to unmarshal OutboxEvent JSON: %w", err)
+			}
+
+			var deletedEvent userv1.UserDeletedEvent
+			if err := protojson.Unmarshal([]byte(event.Payload), &deletedEvent); err != nil {
+				return fmt.Errorf("failed to unmarshal UserDeletedEvent payload: %w", err)
+			}
+
+			_, err := userController.InvalidateUserCache(ctx,
+				connect.NewRequest(&userv1.InvalidateUserCacheRequest{
+					UserId: deletedEvent.UserId,
+				}),
+			)
+			return err
+		},
 	}
 
 	go func() {
@@ -0,0 +1,151 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
+)
+
+// FollowerCountReport summarises a follower counter repair.
+type FollowerCountReport struct {
+	UsersChecked  int
+	UsersDrifted  int
+	Corrected     int   // users whose counters were rewritten (0 on a dry run)
+	FollowerSkew  int64 // sum of |stored - actual| over follower_count
+	FollowingSkew int64 // sum of |stored - actual| over following_count
+}
+
+// FollowerCounts recomputes follower_count and following_count from followers_by_user
+// and following_by_user and corrects any drift. With opts.UserID set only that user is
+// checked; otherwise every user is visited by scanning the users table in token ranges.
+//
+// Follows landing while a user is being repaired can still skew that user by the
+// in-flight amount; re-running converges.
+func FollowerCounts(ctx context.Context, repo *repository.UserRepository, opts Options) (FollowerCountReport, error) {
+	var report FollowerCountReport
+
+	wait, stop := helpers.Throttle(opts.Rate)
+	defer stop()
+
+	repair := func(userID int64) error {
+		if err := wait(ctx); err != nil {
+			return err
+		}
+		return repairUserCounts(ctx, repo, userID, opts.DryRun, &report)
+	}
+
+	if opts.UserID != 0 {
+		return report, repair(opts.UserID)
+	}
+
+	for _, tr := range splitTokenRing(max(opts.Segments, 1)) {
+		var pageState []byte
+		for {
+			ids, next, err := repo.ScanUserIDsInTokenRange(ctx, tr.start, tr.end, opts.PageSize, pageState)
+			if err != nil {
+				return report, fmt.Errorf("failed to scan users in (%d, %d]: %w", tr.start, tr.end, err)
+			}
+
+			for _, id := range ids {
+				if err := repair(id); err != nil {
+					return report, err
+				}
+			}
+
+			if len(next) == 0 {
+				break
+			}
+			pageState = next
+		}
+
+		slog.Info("token range repaired", "start", tr.start, "end", tr.end, "users_checked", report.UsersChecked)
+	}
+
+	return report, nil
+}
+
+func repairUserCounts(ctx context.Context, repo *repository.UserRepository, userID int64, dryRun bool, report *FollowerCountReport) error {
+	storedFollowers, storedFollowing, err := repo.GetFollowerCounts(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	actualFollowers, actualFollowing, err := repo.CountFollowRelations(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	followerDelta := actualFollowers - storedFollowers
+	followingDelta := actualFollowing - storedFollowing
+	if !report.record(followerDelta, followingDelta) {
+		return nil
+	}
+
+	slog.Info("follower count drift",
+		"user_id", userID,
+		"follower_count", storedFollowers, "followers", actualFollowers,
+		"following_count", storedFollowing, "following", actualFollowing,
+		"dry_run", dryRun)
+
+	if dryRun {
+		return nil
+	}
+
+	if err := repo.AdjustFollowerCounts(ctx, userID, followerDelta, followingDelta); err != nil {
+		return err
+	}
+	report.Corrected++
+
+	return nil
+}
+
+// record counts a checked user whose counters are off by the given deltas and reports
+// whether they drifted at all.
+func (r *FollowerCountReport) record(followerDelta, followingDelta int64) bool {
+	r.UsersChecked++
+	if followerDelta == 0 && followingDelta == 0 {
+		return false
+	}
+
+	r.UsersDrifted++
+	r.FollowerSkew += abs(followerDelta)
+	r.FollowingSkew += abs(followingDelta)
+	return true
+}
+
+type tokenRange struct {
+	start, end int64 // (start, end]
+}
+
+// splitTokenRing divides the Murmur3 token ring into n contiguous ranges. The first
+// range starts at MinInt64 inclusive, which token(id) > MinInt64 covers since
+// Murmur3 never produces MinInt64.
+func splitTokenRing(n int) []tokenRange {
+	lo, hi := big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)
+	width := new(big.Int).Sub(hi, lo)
+	width.Div(width, big.NewInt(int64(n)))
+
+	ranges := make([]tokenRange, n)
+	start := new(big.Int).Set(lo)
+	for i := range n {
+		end := new(big.Int).Add(start, width)
+		if i == n-1 {
+			end.Set(hi)
+		}
+		ranges[i] = tokenRange{start: start.Int64(), end: end.Int64()}
+		start = end
+	}
+	return ranges
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
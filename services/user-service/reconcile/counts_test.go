@@ -0,0 +1,50 @@
+package reconcile
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSplitTokenRing(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7, 64} {
+		ranges := splitTokenRing(n)
+		if len(ranges) != n {
+			t.Fatalf("splitTokenRing(%d) returned %d ranges", n, len(ranges))
+		}
+		if ranges[0].start != math.MinInt64 || ranges[n-1].end != math.MaxInt64 {
+			t.Errorf("splitTokenRing(%d) covers (%d, %d], want the whole ring", n, ranges[0].start, ranges[n-1].end)
+		}
+		for i, tr := range ranges {
+			if tr.start >= tr.end {
+				t.Errorf("splitTokenRing(%d) range %d is empty: (%d, %d]", n, i, tr.start, tr.end)
+			}
+			if i > 0 && tr.start != ranges[i-1].end {
+				t.Errorf("splitTokenRing(%d) range %d starts at %d, previous ends at %d", n, i, tr.start, ranges[i-1].end)
+			}
+		}
+	}
+}
+
+func TestFollowerCountReportRecord(t *testing.T) {
+	tests := []struct {
+		name                          string
+		followerDelta, followingDelta int64
+		wantDrift                     bool
+	}{
+		{"in step", 0, 0, false},
+		{"missing followers", 3, 0, true},
+		{"overcounted following", 0, -2, true},
+	}
+
+	var report FollowerCountReport
+	for _, tt := range tests {
+		if got := report.record(tt.followerDelta, tt.followingDelta); got != tt.wantDrift {
+			t.Errorf("%s: record(%d, %d) = %v, want %v", tt.name, tt.followerDelta, tt.followingDelta, got, tt.wantDrift)
+		}
+	}
+
+	want := FollowerCountReport{UsersChecked: 3, UsersDrifted: 2, FollowerSkew: 3, FollowingSkew: 2}
+	if report != want {
+		t.Errorf("report = %+v, want %+v", report, want)
+	}
+}
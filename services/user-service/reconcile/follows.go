@@ -0,0 +1,100 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
+)
+
+// FollowCacheReport summarises a follow cache reconciliation.
+type FollowCacheReport struct {
+	RowsScanned  int // following_by_user rows read
+	Warmed       int // rows that had no cache entry
+	KeysScanned  int // follow cache keys read
+	StaleRemoved int // cache keys with no following_by_user row
+}
+
+// FollowCache makes the Redis follow cache agree with following_by_user. It first
+// walks the table and writes any missing keys, then walks the cache and deletes keys
+// whose relation no longer exists in Cassandra.
+func FollowCache(ctx context.Context, repo *repository.UserRepository, opts Options) (FollowCacheReport, error) {
+	var report FollowCacheReport
+
+	wait, stop := helpers.Throttle(opts.Rate)
+	defer stop()
+
+	var pageState []byte
+	for {
+		if err := wait(ctx); err != nil {
+			return report, err
+		}
+
+		edges, next, err := repo.ScanFollowing(ctx, opts.PageSize, pageState)
+		if err != nil {
+			return report, fmt.Errorf("failed to scan following_by_user: %w", err)
+		}
+		report.RowsScanned += len(edges)
+
+		missing, err := repo.MissingCachedFollows(ctx, edges)
+		if err != nil {
+			return report, fmt.Errorf("failed to check follow cache: %w", err)
+		}
+		report.Warmed += len(missing)
+
+		if !opts.DryRun {
+			if err := repo.CacheFollows(ctx, missing); err != nil {
+				return report, fmt.Errorf("failed to warm follow cache: %w", err)
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+		pageState = next
+	}
+
+	slog.Info("follow cache warm pass complete", "rows", report.RowsScanned, "missing", report.Warmed, "dry_run", opts.DryRun)
+
+	var cursor uint64
+	for {
+		if err := wait(ctx); err != nil {
+			return report, err
+		}
+
+		edges, next, err := repo.ScanFollowCache(ctx, cursor, int64(opts.PageSize))
+		if err != nil {
+			return report, fmt.Errorf("failed to scan follow cache: %w", err)
+		}
+		report.KeysScanned += len(edges)
+
+		for _, edge := range edges {
+			exists, err := repo.HasFollowRelation(ctx, edge.UserID, edge.FollowingID)
+			if err != nil {
+				return report, fmt.Errorf("failed to check follow %d -> %d: %w", edge.UserID, edge.FollowingID, err)
+			}
+			if exists {
+				continue
+			}
+
+			report.StaleRemoved++
+			if opts.DryRun {
+				continue
+			}
+			if err := repo.UnfollowUserCached(ctx, edge.UserID, edge.FollowingID); err != nil {
+				return report, fmt.Errorf("failed to remove stale follow %d -> %d: %w", edge.UserID, edge.FollowingID, err)
+			}
+		}
+
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+
+	slog.Info("follow cache prune pass complete", "keys", report.KeysScanned, "stale", report.StaleRemoved, "dry_run", opts.DryRun)
+
+	return report, nil
+}
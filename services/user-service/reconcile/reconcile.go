@@ -0,0 +1,11 @@
+package reconcile
+
+// Options controls a reconciliation run.
+type Options struct {
+	DryRun   bool // report drift without writing anything
+	PageSize int  // rows per Cassandra page / keys per Redis SCAN
+	Rate     int  // max pages (or users, for count repair) per second, 0 for unlimited
+
+	UserID   int64 // count repair: only this user when set
+	Segments int   // count repair: token ranges to split the users table into
+}
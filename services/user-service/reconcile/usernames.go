@@ -0,0 +1,81 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/threads-go-backend/services/user-service/repository"
+	"github.com/yaninyzwitty/threads-go-backend/shared/helpers"
+)
+
+// UsernameReport summarises a username claim backfill.
+type UsernameReport struct {
+	UsersScanned int
+	Claimed      int // usernames claimed for their account (would be, on a dry run)
+	Conflicts    int // usernames already held by another account
+}
+
+// Usernames claims the username of every account in the users table. Accounts from
+// before usernames existed are not resolvable by @mention until claimed. When two
+// accounts share a handle, ignoring case, the first claimed keeps it and the other is
+// logged for a manual rename.
+func Usernames(ctx context.Context, repo *repository.UserRepository, opts Options) (UsernameReport, error) {
+	var report UsernameReport
+
+	wait, stop := helpers.Throttle(opts.Rate)
+	defer stop()
+
+	var pageState []byte
+	for {
+		if err := wait(ctx); err != nil {
+			return report, err
+		}
+
+		users, next, err := repo.ListUsers(ctx, opts.PageSize, pageState)
+		if err != nil {
+			return report, fmt.Errorf("failed to scan users: %w", err)
+		}
+		report.UsersScanned += len(users)
+
+		for _, user := range users {
+			if user.Username == "" {
+				continue
+			}
+
+			if opts.DryRun {
+				owner, err := repo.GetUserIDByUsername(ctx, user.Username)
+				switch {
+				case errors.Is(err, gocql.ErrNotFound):
+					report.Claimed++
+				case err != nil:
+					return report, err
+				case owner != user.Id:
+					report.Conflicts++
+					slog.Warn("username held by another account", "username", user.Username, "user_id", user.Id, "owner_id", owner)
+				}
+				continue
+			}
+
+			err := repo.ClaimUsername(ctx, user.Username, user.Id)
+			switch {
+			case errors.Is(err, repository.ErrUsernameTaken):
+				report.Conflicts++
+				slog.Warn("username held by another account", "username", user.Username, "user_id", user.Id)
+			case err != nil:
+				return report, err
+			default:
+				report.Claimed++
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+		pageState = next
+	}
+
+	return report, nil
+}
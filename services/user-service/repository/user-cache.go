@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/redis/go-redis/v9"
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	userCacheTTL         = 10 * time.Minute
+	userCacheJitter      = userCacheTTL / 10 // +/- spread so a burst of fills doesn't expire together
+	userCacheNegativeTTL = 30 * time.Second
+	batchGetReadLimit    = 16 // concurrent Cassandra reads per BatchGetUsers call
+
+	// userCacheMissing marks an id known not to exist. A real user never encodes to
+	// an empty message because its id is non-zero.
+	userCacheMissing = ""
+)
+
+// UserCacheStats exposes user cache counters on /debug/vars under "user_cache".
+var UserCacheStats = expvar.NewMap("user_cache")
+
+func userCacheKey(id int64) string {
+	return fmt.Sprintf("user:%d:record", id)
+}
+
+func userCacheExpiry() time.Duration {
+	return userCacheTTL - userCacheJitter + rand.N(2*userCacheJitter)
+}
+
+// GetUserByID reads a user through the Redis cache. Missing users are cached briefly
+// as well and reported as gocql.ErrNotFound.
+func (r *UserRepository) GetUserByID(ctx context.Context, id int64) (*userv1.User, error) {
+	raw, err := r.cache.Get(ctx, userCacheKey(id)).Result()
+	switch {
+	case err == nil:
+		if raw == userCacheMissing {
+			UserCacheStats.Add("negative_hits", 1)
+			return nil, gocql.ErrNotFound
+		}
+		if user, ok := decodeCachedUser(raw); ok {
+			return user, nil
+		}
+	case err != redis.Nil:
+		UserCacheStats.Add("errors", 1)
+		slog.Warn("user cache read failed", "user_id", id, "error", err)
+	}
+
+	UserCacheStats.Add("misses", 1)
+	return r.loadUser(ctx, id)
+}
+
+// BatchGetUsers reads users through the Redis cache, loading misses from Cassandra with
+// bounded concurrency. Unknown ids are left out of the result.
+func (r *UserRepository) BatchGetUsers(ctx context.Context, ids []int64) (map[int64]*userv1.User, error) {
+	users := make(map[int64]*userv1.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = userCacheKey(id)
+	}
+
+	var misses []int64
+	vals, err := r.cache.MGet(ctx, keys...).Result()
+	if err != nil {
+		// Cache is an optimisation; fall through to Cassandra for everything.
+		UserCacheStats.Add("errors", 1)
+		slog.Warn("user cache read failed", "error", err)
+		misses = ids
+	} else {
+		for i, val := range vals {
+			raw, ok := val.(string)
+			if !ok {
+				misses = append(misses, ids[i])
+				continue
+			}
+			if raw == userCacheMissing {
+				UserCacheStats.Add("negative_hits", 1)
+				continue
+			}
+			if user, ok := decodeCachedUser(raw); ok {
+				users[user.Id] = user
+				continue
+			}
+			misses = append(misses, ids[i])
+		}
+	}
+	UserCacheStats.Add("misses", int64(len(misses)))
+
+	var mu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(batchGetReadLimit)
+	for _, id := range misses {
+		g.Go(func() error {
+			user, err := r.loadUser(gctx, id)
+			if err == gocql.ErrNotFound {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get user %d: %w", id, err)
+			}
+
+			mu.Lock()
+			users[id] = user
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) InvalidateCachedUser(ctx context.Context, id int64) error {
+	UserCacheStats.Add("invalidations", 1)
+	return r.cache.Del(ctx, userCacheKey(id)).Err()
+}
+
+// loadUser reads the user from Cassandra and fills the cache. Concurrent loads for the
+// same id share one query.
+func (r *UserRepository) loadUser(ctx context.Context, id int64) (*userv1.User, error) {
+	key := strconv.FormatInt(id, 10)
+
+	v, err, _ := r.loads.Do(key, func() (any, error) {
+		UserCacheStats.Add("loads", 1)
+
+		// Detach from the first caller's cancellation; other callers share this result.
+		loadCtx := context.WithoutCancel(ctx)
+
+		user, err := r.getUserFromDB(loadCtx, id)
+		if errors.Is(err, gocql.ErrNotFound) {
+			if err := r.cache.Set(loadCtx, userCacheKey(id), userCacheMissing, userCacheNegativeTTL).Err(); err != nil {
+				slog.Warn("user cache fill failed", "user_id", id, "error", err)
+			}
+			return nil, gocql.ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		raw, err := proto.Marshal(user)
+		if err == nil {
+			err = r.cache.Set(loadCtx, userCacheKey(id), raw, userCacheExpiry()).Err()
+		}
+		if err != nil {
+			slog.Warn("user cache fill failed", "user_id", id, "error", err)
+		}
+
+		return user, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Callers may mutate the result (UpdateUser does), so never hand out the shared value.
+	return proto.Clone(v.(*userv1.User)).(*userv1.User), nil
+}
+
+func decodeCachedUser(raw string) (*userv1.User, bool) {
+	var user userv1.User
+	if err := proto.Unmarshal([]byte(raw), &user); err != nil {
+		return nil, false
+	}
+
+	UserCacheStats.Add("hits", 1)
+	return &user, true
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"testing"
+
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUserCacheExpiry(t *testing.T) {
+	for range 1000 {
+		got := userCacheExpiry()
+		if got < userCacheTTL-userCacheJitter || got >= userCacheTTL+userCacheJitter {
+			t.Fatalf("userCacheExpiry() = %v, want within %v of %v", got, userCacheJitter, userCacheTTL)
+		}
+	}
+}
+
+func TestDecodeCachedUser(t *testing.T) {
+	encoded, err := proto.Marshal(&userv1.User{Id: 7, Username: "ann", Bio: "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		raw    string
+		wantOK bool
+		wantID int64
+	}{
+		{"cached user", string(encoded), true, 7},
+		{"corrupt entry", "\xff\xff\xff", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, ok := decodeCachedUser(tt.raw)
+			if ok != tt.wantOK {
+				t.Fatalf("decodeCachedUser() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && user.GetId() != tt.wantID {
+				t.Errorf("decoded user id = %d, want %d", user.GetId(), tt.wantID)
+			}
+		})
+	}
+}
+
+func TestUserCacheMissingIsNotAUser(t *testing.T) {
+	// GetUserByID tells a cached miss from a user by the empty value alone, which only
+	// works while no real user encodes to it.
+	encoded, err := proto.Marshal(&userv1.User{Id: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) == userCacheMissing {
+		t.Fatalf("user 1 encodes to the negative cache marker %q", userCacheMissing)
+	}
+}
@@ -2,19 +2,36 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/redis/go-redis/v9"
 	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ErrUsernameTaken is returned when another account holds the username.
+var ErrUsernameTaken = errors.New("username is already taken")
+
+// ErrUserTagsChanged is returned when a tag replace keeps losing to concurrent ones.
+var ErrUserTagsChanged = errors.New("tags were changed concurrently")
+
+// maxTagReplaceAttempts bounds how often ReplaceUserTags retries a lost version update.
+const maxTagReplaceAttempts = 3
+
 type UserRepository struct {
 	session *gocql.Session
 	cache   *redis.Client
+	loads   singleflight.Group // collapses concurrent cache misses for the same user
 }
 
 func NewUserRepository(session *gocql.Session, cache *redis.Client) *UserRepository {
@@ -24,7 +41,7 @@ func NewUserRepository(session *gocql.Session, cache *redis.Client) *UserReposit
 	}
 }
 
-func (r *UserRepository) CreateUser(ctx context.Context, user *userv1.User) error {
+func (r *UserRepository) CreateUser(ctx context.Context, user *userv1.User, passwordHash string) error {
 	query := `
 		INSERT INTO threads_keyspace.users 
 		(id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at, password) 
@@ -32,18 +49,19 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *userv1.User) erro
 
 	return r.session.Query(query,
 		user.Id, user.Username, user.FullName, user.Email, user.ProfilePicUrl,
-		user.CreatedAt.AsTime(), user.UpdatedAt.AsTime(), user.Password).
+		user.CreatedAt.AsTime(), user.UpdatedAt.AsTime(), passwordHash).
 		WithContext(ctx).
 		Exec()
 }
 
-func (r *UserRepository) CreateUserWithInitialCounts(ctx context.Context, user *userv1.User) error {
+func (r *UserRepository) CreateUserWithInitialCounts(ctx context.Context, user *userv1.User, passwordHash string) error {
 	const (
 		insertUserQuery = `
 			INSERT INTO threads_keyspace.users (
 				id, username, full_name, email, password, profile_pic_url,
-				is_verified, created_at, updated_at
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+				is_verified, created_at, updated_at,
+				bio, links, pronouns, location, banner_url
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 		insertOutboxQuery = `
 			INSERT INTO threads_keyspace.outbox (
@@ -66,7 +84,8 @@ func (r *UserRepository) CreateUserWithInitialCounts(ctx context.Context, user *
 
 	// Insert user
 	batch.Query(insertUserQuery, user.Id, user.Username, user.FullName, user.Email,
-		user.Password, user.ProfilePicUrl, user.IsVerified, user.CreatedAt.AsTime(), user.UpdatedAt.AsTime())
+		passwordHash, user.ProfilePicUrl, user.IsVerified, user.CreatedAt.AsTime(), user.UpdatedAt.AsTime(),
+		user.Bio, user.Links, user.Pronouns, user.Location, user.BannerUrl)
 
 	// Insert outbox event
 	batch.Query(insertOutboxQuery, eventType, payload)
@@ -80,26 +99,78 @@ func (r *UserRepository) CreateUserWithInitialCounts(ctx context.Context, user *
 }
 
 func (r *UserRepository) UpdateUser(ctx context.Context, user *userv1.User) error {
-	query := `
-		UPDATE threads_keyspace.users 
-		SET username = ?, full_name = ?, email = ?, profile_pic_url = ?, updated_at = ? 
-		WHERE id = ?`
+	const (
+		updateUserQuery = `
+			UPDATE threads_keyspace.users 
+			SET username = ?, full_name = ?, email = ?, profile_pic_url = ?, updated_at = ?,
+				bio = ?, links = ?, pronouns = ?, location = ?, banner_url = ?
+			WHERE id = ?`
+
+		outboxQuery = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (uuid(), ?, ?, false) USING TTL 86400`
+		eventType   = "user.updated"
+	)
 
-	return r.session.Query(query,
+	// Consumers only need the public profile; the email stays in the users table.
+	event := proto.Clone(user).(*userv1.User)
+	event.Email = ""
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user for outbox: %w", err)
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	batch.Query(updateUserQuery,
 		user.Username, user.FullName, user.Email, user.ProfilePicUrl,
-		user.UpdatedAt.AsTime(), user.Id).
-		WithContext(ctx).
-		Exec()
+		user.UpdatedAt.AsTime(), user.Bio, user.Links, user.Pronouns, user.Location, user.BannerUrl,
+		user.Id)
+	batch.Query(outboxQuery, eventType, payload)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to execute user update batch: %w", err)
+	}
+
+	// Drop our own copy now for read-your-writes; the user.updated consumer
+	// invalidates again to catch readers that refilled it in between.
+	return r.InvalidateCachedUser(ctx, user.Id)
 }
 
-func (r *UserRepository) DeleteUser(ctx context.Context, id int64) error {
-	query := `DELETE FROM threads_keyspace.users WHERE id = ?`
-	return r.session.Query(query, id).WithContext(ctx).Exec()
+func (r *UserRepository) DeleteUser(ctx context.Context, id int64, now time.Time) error {
+	const (
+		deleteUserQuery = `DELETE FROM threads_keyspace.users WHERE id = ?`
+		outboxQuery     = `INSERT INTO threads_keyspace.outbox (event_id, event_type, payload, published) VALUES (?, ?, ?, false) USING TTL 86400`
+		eventType       = "user.deleted"
+	)
+
+	// The payload carries the outbox event id so consumers can dedupe on it.
+	eventId := gocql.TimeUUID()
+
+	payload, err := protojson.Marshal(&userv1.UserDeletedEvent{
+		EventId:   eventId.String(),
+		UserId:    id,
+		DeletedAt: timestamppb.New(now),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal user deleted event: %w", err)
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	batch.Query(deleteUserQuery, id)
+	batch.Query(outboxQuery, eventId, eventType, payload)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to execute user deletion batch: %w", err)
+	}
+
+	return r.InvalidateCachedUser(ctx, id)
 }
 
-func (r *UserRepository) GetUserByID(ctx context.Context, id int64) (*userv1.User, error) {
+func (r *UserRepository) getUserFromDB(ctx context.Context, id int64) (*userv1.User, error) {
 	query := `
-		SELECT id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at, password 
+		SELECT id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at,
+			bio, links, pronouns, location, banner_url
 		FROM threads_keyspace.users 
 		WHERE id = ?`
 
@@ -108,7 +179,8 @@ func (r *UserRepository) GetUserByID(ctx context.Context, id int64) (*userv1.Use
 
 	err := r.session.Query(query, id).WithContext(ctx).
 		Scan(&user.Id, &user.Username, &user.FullName, &user.Email, &user.ProfilePicUrl,
-			&user.IsVerified, &createdAt, &updatedAt, &user.Password)
+			&user.IsVerified, &createdAt, &updatedAt,
+			&user.Bio, &user.Links, &user.Pronouns, &user.Location, &user.BannerUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -118,30 +190,77 @@ func (r *UserRepository) GetUserByID(ctx context.Context, id int64) (*userv1.Use
 	return &user, nil
 }
 
-func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*userv1.User, error) {
+// GetUserIDByUsername returns the id of the user holding the username, ignoring case.
+// gocql.ErrNotFound is returned when nobody has claimed it.
+func (r *UserRepository) GetUserIDByUsername(ctx context.Context, username string) (int64, error) {
+	query := `SELECT user_id FROM threads_keyspace.usernames WHERE username = ?`
+
+	var id int64
+	if err := r.session.Query(query, strings.ToLower(username)).WithContext(ctx).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// ClaimUsername reserves the username for userID. The row is keyed by the lower-cased
+// name and inserted with a lightweight transaction, so names differing only in case
+// can't be held by two accounts. Claiming a name the user already holds succeeds;
+// ErrUsernameTaken is returned when someone else holds it.
+func (r *UserRepository) ClaimUsername(ctx context.Context, username string, userID int64) error {
+	query := `INSERT INTO threads_keyspace.usernames (username, user_id) VALUES (?, ?) IF NOT EXISTS`
+
+	existing := map[string]interface{}{}
+	applied, err := r.session.Query(query, strings.ToLower(username), userID).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return fmt.Errorf("failed to claim username %q: %w", username, err)
+	}
+	if !applied {
+		if owner, _ := existing["user_id"].(int64); owner != userID {
+			return ErrUsernameTaken
+		}
+	}
+	return nil
+}
+
+// ReleaseUsername frees the username if userID still holds it.
+func (r *UserRepository) ReleaseUsername(ctx context.Context, username string, userID int64) error {
+	query := `DELETE FROM threads_keyspace.usernames WHERE username = ? IF user_id = ?`
+
+	if _, err := r.session.Query(query, strings.ToLower(username), userID).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+		return fmt.Errorf("failed to release username %q: %w", username, err)
+	}
+	return nil
+}
+
+// GetUserByEmail returns the user together with their bcrypt password hash, for login only.
+func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*userv1.User, string, error) {
 	query := `
-		SELECT id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at, password 
+		SELECT id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at, password,
+			bio, links, pronouns, location, banner_url
 		FROM threads_keyspace.users 
 		WHERE email = ?`
 
 	var user userv1.User
 	var createdAt, updatedAt time.Time
+	var passwordHash string
 
 	err := r.session.Query(query, email).WithContext(ctx).
 		Scan(&user.Id, &user.Username, &user.FullName, &user.Email, &user.ProfilePicUrl,
-			&user.IsVerified, &createdAt, &updatedAt, &user.Password)
+			&user.IsVerified, &createdAt, &updatedAt, &passwordHash,
+			&user.Bio, &user.Links, &user.Pronouns, &user.Location, &user.BannerUrl)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	user.CreatedAt = timestamppb.New(createdAt)
 	user.UpdatedAt = timestamppb.New(updatedAt)
-	return &user, nil
+	return &user, passwordHash, nil
 }
 
 func (r *UserRepository) ListUsers(ctx context.Context, pageSize int, pagingState []byte) ([]*userv1.User, []byte, error) {
 	query := `
-		SELECT id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at 
+		SELECT id, username, full_name, email, profile_pic_url, is_verified, created_at, updated_at,
+			bio, links, pronouns, location, banner_url
 		FROM threads_keyspace.users`
 
 	iter := r.session.Query(query).
@@ -160,9 +279,15 @@ func (r *UserRepository) ListUsers(ctx context.Context, pageSize int, pagingStat
 		isVerified    bool
 		createdAt     time.Time
 		updatedAt     time.Time
+		bio           string
+		links         []string
+		pronouns      string
+		location      string
+		bannerURL     string
 	)
 
-	for iter.Scan(&id, &username, &fullName, &email, &profilePicURL, &isVerified, &createdAt, &updatedAt) {
+	for iter.Scan(&id, &username, &fullName, &email, &profilePicURL, &isVerified, &createdAt, &updatedAt,
+		&bio, &links, &pronouns, &location, &bannerURL) {
 		users = append(users, &userv1.User{
 			Id:            id,
 			Username:      username,
@@ -172,6 +297,11 @@ func (r *UserRepository) ListUsers(ctx context.Context, pageSize int, pagingStat
 			IsVerified:    isVerified,
 			CreatedAt:     timestamppb.New(createdAt),
 			UpdatedAt:     timestamppb.New(updatedAt),
+			Bio:           bio,
+			Links:         links,
+			Pronouns:      pronouns,
+			Location:      location,
+			BannerUrl:     bannerURL,
 		})
 	}
 
@@ -224,6 +354,12 @@ func (r *UserRepository) SaveFollowRelationAndEmitEvent(ctx context.Context, use
 		return fmt.Errorf("failed to execute follow batch: %w", err)
 	}
 
+	// Warm the cache now rather than waiting for the user.followed consumer, so a quick
+	// second follow is rejected without a Cassandra read.
+	if err := r.FollowUserCached(ctx, userID, followingID); err != nil {
+		slog.Warn("follow cache fill failed", "user_id", userID, "following_id", followingID, "error", err)
+	}
+
 	return nil
 }
 
@@ -240,9 +376,10 @@ func (r *UserRepository) UnfollowUser(ctx context.Context, followerID, userId in
 	batch.Query(unfollowFollowerQuery, userId, followerID)
 	batch.Query(unfollowFollowingQuery, followerID, userId)
 
+	// Same orientation as FollowedEvent: UserId is the follower, FollowingId the followed user.
 	payload, err := protojson.Marshal(&userv1.UnfollowedEvent{
-		UserId:       userId,
-		FollowingId:  followerID,
+		UserId:       followerID,
+		FollowingId:  userId,
 		UnfollowedAt: timestamppb.New(now),
 	})
 	if err != nil {
@@ -255,6 +392,11 @@ func (r *UserRepository) UnfollowUser(ctx context.Context, followerID, userId in
 		return fmt.Errorf("failed to execute unfollow batch: %w", err)
 	}
 
+	// A stale cache entry would make a re-follow look like a duplicate.
+	if err := r.UnfollowUserCached(ctx, followerID, userId); err != nil {
+		slog.Warn("follow cache delete failed", "user_id", followerID, "following_id", userId, "error", err)
+	}
+
 	return nil
 }
 
@@ -327,26 +469,150 @@ func (r *UserRepository) SafeDecrement(ctx context.Context, userID int64, column
 		Exec()
 }
 
+// FollowEdge is one follower -> followed relation.
+type FollowEdge struct {
+	UserID      int64
+	FollowingID int64
+}
+
+func followCacheKey(userId, followingId int64) string {
+	return fmt.Sprintf("user:%d:following:%d", userId, followingId)
+}
+
 func (r *UserRepository) FollowUserCached(ctx context.Context, userId, followingId int64) error {
-	key := fmt.Sprintf("user:%d:following:%d", userId, followingId)
-	return r.cache.Set(ctx, key, "1", 0).Err() // no expiration
+	return r.cache.Set(ctx, followCacheKey(userId, followingId), "1", 0).Err() // no expiration
 }
 
+// IsFollowing answers from the Redis follow cache and falls back to following_by_user
+// on a miss, since the cache is filled asynchronously and may have been flushed.
+// A relation found in Cassandra is written back to the cache.
 func (r *UserRepository) IsFollowing(ctx context.Context, userId, followingId int64) (bool, error) {
-	key := fmt.Sprintf("user:%d:following:%d", userId, followingId)
+	key := followCacheKey(userId, followingId)
 	val, err := r.cache.Get(ctx, key).Result()
-	if err == redis.Nil {
-		return false, nil
+	if err == nil {
+		return val == "1", nil
+	}
+	if err != redis.Nil {
+		slog.Warn("follow cache read failed", "user_id", userId, "following_id", followingId, "error", err)
 	}
+
+	following, err := r.HasFollowRelation(ctx, userId, followingId)
 	if err != nil {
 		return false, err
 	}
-	return val == "1", nil
+
+	if following {
+		if err := r.FollowUserCached(ctx, userId, followingId); err != nil {
+			slog.Warn("follow cache fill failed", "user_id", userId, "following_id", followingId, "error", err)
+		}
+	}
+
+	return following, nil
 }
 
 func (r *UserRepository) UnfollowUserCached(ctx context.Context, userId, followingId int64) error {
-	key := fmt.Sprintf("user:%d:following:%d", userId, followingId)
-	return r.cache.Del(ctx, key).Err()
+	return r.cache.Del(ctx, followCacheKey(userId, followingId)).Err()
+}
+
+// ScanFollowing pages through every row of following_by_user.
+func (r *UserRepository) ScanFollowing(ctx context.Context, pageSize int, pagingState []byte) ([]FollowEdge, []byte, error) {
+	query := `SELECT user_id, following_id FROM threads_keyspace.following_by_user`
+
+	iter := r.session.Query(query).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		edges               []FollowEdge
+		userId, followingId int64
+	)
+	for iter.Scan(&userId, &followingId) {
+		edges = append(edges, FollowEdge{UserID: userId, FollowingID: followingId})
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return edges, nextPageState, nil
+}
+
+// MissingCachedFollows returns the edges that have no entry in the follow cache.
+func (r *UserRepository) MissingCachedFollows(ctx context.Context, edges []FollowEdge) ([]FollowEdge, error) {
+	if len(edges) == 0 {
+		return nil, nil
+	}
+
+	pipe := r.cache.Pipeline()
+	cmds := make([]*redis.IntCmd, len(edges))
+	for i, edge := range edges {
+		cmds[i] = pipe.Exists(ctx, followCacheKey(edge.UserID, edge.FollowingID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	var missing []FollowEdge
+	for i, cmd := range cmds {
+		if cmd.Val() == 0 {
+			missing = append(missing, edges[i])
+		}
+	}
+	return missing, nil
+}
+
+func (r *UserRepository) CacheFollows(ctx context.Context, edges []FollowEdge) error {
+	if len(edges) == 0 {
+		return nil
+	}
+
+	pipe := r.cache.Pipeline()
+	for _, edge := range edges {
+		pipe.Set(ctx, followCacheKey(edge.UserID, edge.FollowingID), "1", 0)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ScanFollowCache walks the follow cache keys with SCAN, returning the parsed edges
+// and the cursor for the next call (0 when done).
+func (r *UserRepository) ScanFollowCache(ctx context.Context, cursor uint64, count int64) ([]FollowEdge, uint64, error) {
+	keys, next, err := r.cache.Scan(ctx, cursor, "user:*:following:*", count).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	edges := make([]FollowEdge, 0, len(keys))
+	for _, key := range keys {
+		if edge, ok := parseFollowCacheKey(key); ok {
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges, next, nil
+}
+
+// parseFollowCacheKey reverses followCacheKey. Keys the glob matches that followCacheKey
+// can't have produced are rejected, so the prune pass never acts on a different key.
+func parseFollowCacheKey(key string) (FollowEdge, bool) {
+	parts := strings.Split(key, ":")
+	if len(parts) != 4 || parts[0] != "user" || parts[2] != "following" {
+		return FollowEdge{}, false
+	}
+
+	userId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return FollowEdge{}, false
+	}
+	followingId, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return FollowEdge{}, false
+	}
+	return FollowEdge{UserID: userId, FollowingID: followingId}, true
 }
 
 func (r *UserRepository) InsertFollowerCount(ctx context.Context, userId int64) error {
@@ -357,3 +623,388 @@ func (r *UserRepository) InsertFollowerCount(ctx context.Context, userId int64)
 
 	return r.session.Query(insertFollowerCountsQuery, userId).WithContext(ctx).Exec()
 }
+
+func (r *UserRepository) GetFollowSuggestions(ctx context.Context, userID int64, limit int) ([]*userv1.FollowSuggestion, time.Time, error) {
+	query := `
+		SELECT suggested_id, score, mutual_count, computed_at
+		FROM threads_keyspace.follow_suggestions_by_user
+		WHERE user_id = ?
+		LIMIT ?`
+
+	iter := r.session.Query(query, userID, limit).WithContext(ctx).Iter()
+
+	var (
+		suggestions []*userv1.FollowSuggestion
+		suggestedID int64
+		score       float64
+		mutualCount int
+		computedAt  time.Time
+		latest      time.Time
+	)
+
+	for iter.Scan(&suggestedID, &score, &mutualCount, &computedAt) {
+		suggestions = append(suggestions, &userv1.FollowSuggestion{
+			UserId:      suggestedID,
+			Score:       score,
+			MutualCount: int32(mutualCount),
+		})
+		if computedAt.After(latest) {
+			latest = computedAt
+		}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return suggestions, latest, nil
+}
+
+// GetFollowerCounts returns the stored counters as-is; drift can leave them negative.
+func (r *UserRepository) GetFollowerCounts(ctx context.Context, userID int64) (followers, following int64, err error) {
+	query := `
+		SELECT follower_count, following_count
+		FROM threads_keyspace.follower_counts
+		WHERE user_id = ?`
+
+	if err := r.session.Query(query, userID).WithContext(ctx).Scan(&followers, &following); err != nil {
+		if err == gocql.ErrNotFound {
+			return 0, 0, nil
+		}
+		return 0, 0, fmt.Errorf("failed to get follower counts for user %d: %w", userID, err)
+	}
+
+	return followers, following, nil
+}
+
+func (r *UserRepository) GetPostCount(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		SELECT post_count
+		FROM threads_keyspace.post_counts
+		WHERE user_id = ?`
+
+	var count int64
+	if err := r.session.Query(query, userID).WithContext(ctx).Scan(&count); err != nil {
+		if err == gocql.ErrNotFound {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get post count for user %d: %w", userID, err)
+	}
+
+	return max(count, 0), nil
+}
+
+// HasFollowRelation checks following_by_user directly, bypassing the Redis follow cache.
+func (r *UserRepository) HasFollowRelation(ctx context.Context, userID, followingID int64) (bool, error) {
+	query := `
+		SELECT following_id
+		FROM threads_keyspace.following_by_user
+		WHERE user_id = ? AND following_id = ?`
+
+	var id int64
+	if err := r.session.Query(query, userID, followingID).WithContext(ctx).Scan(&id); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// BlockUser writes the block to blocked_users and blocked_by_users in one logged batch.
+func (r *UserRepository) BlockUser(ctx context.Context, userID, blockedID int64, now time.Time) error {
+	const (
+		blockedQuery   = `INSERT INTO threads_keyspace.blocked_users (user_id, blocked_id, blocked_at) VALUES (?, ?, ?)`
+		blockedByQuery = `INSERT INTO threads_keyspace.blocked_by_users (user_id, blocker_id, blocked_at) VALUES (?, ?, ?)`
+	)
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(blockedQuery, userID, blockedID, now)
+	batch.Query(blockedByQuery, blockedID, userID, now)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to execute block batch: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) UnblockUser(ctx context.Context, userID, blockedID int64) error {
+	const (
+		blockedQuery   = `DELETE FROM threads_keyspace.blocked_users WHERE user_id = ? AND blocked_id = ?`
+		blockedByQuery = `DELETE FROM threads_keyspace.blocked_by_users WHERE user_id = ? AND blocker_id = ?`
+	)
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(blockedQuery, userID, blockedID)
+	batch.Query(blockedByQuery, blockedID, userID)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to execute unblock batch: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) HasBlocked(ctx context.Context, userID, blockedID int64) (bool, error) {
+	query := `
+		SELECT blocked_id
+		FROM threads_keyspace.blocked_users
+		WHERE user_id = ? AND blocked_id = ?`
+
+	var id int64
+	if err := r.session.Query(query, userID, blockedID).WithContext(ctx).Scan(&id); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *UserRepository) GetUserTags(ctx context.Context, userID int64) ([]string, error) {
+	query := `SELECT tag FROM threads_keyspace.user_tags WHERE user_id = ?`
+
+	iter := r.session.Query(query, userID).WithContext(ctx).Iter()
+
+	var (
+		tags []string
+		tag  string
+	)
+	for iter.Scan(&tag) {
+		tags = append(tags, tag)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to get tags for user %d: %w", userID, err)
+	}
+
+	return tags, nil
+}
+
+// ReplaceUserTags sets a user's tags to exactly tags. Replaces are serialized through a
+// conditional update of the user's row in user_tag_versions, so each one diffs against
+// the set the previous replace wrote rather than a read that may miss a batch still in
+// flight. The version is also the write timestamp of the user_tags and tag_users batch,
+// so batches landing out of order still leave the newest replace's tags.
+func (r *UserRepository) ReplaceUserTags(ctx context.Context, userID int64, tags []string, now time.Time) error {
+	for range maxTagReplaceAttempts {
+		applied, err := r.replaceUserTagsOnce(ctx, userID, tags, now)
+		if err != nil || applied {
+			return err
+		}
+	}
+	return ErrUserTagsChanged
+}
+
+func (r *UserRepository) replaceUserTagsOnce(ctx context.Context, userID int64, tags []string, now time.Time) (bool, error) {
+	const (
+		selectVersionQuery = `SELECT version, tags FROM threads_keyspace.user_tag_versions WHERE user_id = ?`
+		insertVersionQuery = `INSERT INTO threads_keyspace.user_tag_versions (user_id, version, tags) VALUES (?, ?, ?) IF NOT EXISTS`
+		updateVersionQuery = `UPDATE threads_keyspace.user_tag_versions SET version = ?, tags = ? WHERE user_id = ? IF version = ?`
+
+		insertUserTagQuery = `INSERT INTO threads_keyspace.user_tags (user_id, tag, added_at) VALUES (?, ?, ?)`
+		insertTagUserQuery = `INSERT INTO threads_keyspace.tag_users (tag, user_id) VALUES (?, ?)`
+		deleteUserTagQuery = `DELETE FROM threads_keyspace.user_tags WHERE user_id = ? AND tag = ?`
+		deleteTagUserQuery = `DELETE FROM threads_keyspace.tag_users WHERE tag = ? AND user_id = ?`
+	)
+
+	var (
+		version int64
+		written []string
+	)
+	err := r.session.Query(selectVersionQuery, userID).WithContext(ctx).Scan(&version, &written)
+	exists := err == nil
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return false, fmt.Errorf("failed to get tag version for user %d: %w", userID, err)
+	}
+
+	stored, err := r.GetUserTags(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		// First replace since versions were added: the stored rows are the last state.
+		written = stored
+	}
+
+	next := nextTagVersion(version, now)
+	var applied bool
+	if exists {
+		applied, err = r.session.Query(updateVersionQuery, next, tags, userID, version).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	} else {
+		applied, err = r.session.Query(insertVersionQuery, userID, next, tags).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to advance tag version for user %d: %w", userID, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	add, remove := tagChanges(written, stored, tags)
+	if len(add) == 0 && len(remove) == 0 {
+		return true, nil
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx).WithTimestamp(next)
+	for _, tag := range remove {
+		batch.Query(deleteUserTagQuery, userID, tag)
+		batch.Query(deleteTagUserQuery, tag, userID)
+	}
+	for _, tag := range add {
+		batch.Query(insertUserTagQuery, userID, tag, now)
+		batch.Query(insertTagUserQuery, tag, userID)
+	}
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return false, fmt.Errorf("failed to execute user tags batch: %w", err)
+	}
+	return true, nil
+}
+
+// nextTagVersion returns the version, in microseconds, for a replace following prev. It
+// is the current time unless that would not move past prev.
+func nextTagVersion(prev int64, now time.Time) int64 {
+	return max(now.UnixMicro(), prev+1)
+}
+
+// tagChanges works out the user_tags and tag_users writes that take a user to desired.
+// written is the set the previous replace wrote and stored is what user_tags holds now;
+// they differ while an earlier batch is in flight or after one failed. A tag is added
+// unless both have it, so added_at is kept for tags already in place, and removed if
+// either has it.
+func tagChanges(written, stored, desired []string) (add, remove []string) {
+	for _, tag := range desired {
+		if !slices.Contains(written, tag) || !slices.Contains(stored, tag) {
+			add = append(add, tag)
+		}
+	}
+	for _, tag := range slices.Concat(written, stored) {
+		if !slices.Contains(desired, tag) && !slices.Contains(remove, tag) {
+			remove = append(remove, tag)
+		}
+	}
+	return add, remove
+}
+
+func (r *UserRepository) ListUserIDsByTag(ctx context.Context, tag string, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	query := `SELECT user_id FROM threads_keyspace.tag_users WHERE tag = ?`
+
+	iter := r.session.Query(query, tag).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		userIDs []int64
+		userID  int64
+	)
+	for iter.Scan(&userID) {
+		userIDs = append(userIDs, userID)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return userIDs, nextPageState, nil
+}
+
+// CountFollowRelations counts the rows behind a user's counters: followers from
+// followers_by_user and following from following_by_user.
+func (r *UserRepository) CountFollowRelations(ctx context.Context, userID int64) (followers, following int64, err error) {
+	const (
+		followersQuery = `SELECT COUNT(*) FROM threads_keyspace.followers_by_user WHERE user_id = ?`
+		followingQuery = `SELECT COUNT(*) FROM threads_keyspace.following_by_user WHERE user_id = ?`
+	)
+
+	if err := r.session.Query(followersQuery, userID).WithContext(ctx).Scan(&followers); err != nil {
+		return 0, 0, fmt.Errorf("failed to count followers for user %d: %w", userID, err)
+	}
+	if err := r.session.Query(followingQuery, userID).WithContext(ctx).Scan(&following); err != nil {
+		return 0, 0, fmt.Errorf("failed to count following for user %d: %w", userID, err)
+	}
+
+	return followers, following, nil
+}
+
+// AdjustFollowerCounts applies signed deltas to a user's counters. Counters cannot be
+// set directly, so repairs are expressed as the difference from the stored value.
+func (r *UserRepository) AdjustFollowerCounts(ctx context.Context, userID, followerDelta, followingDelta int64) error {
+	query := `
+		UPDATE threads_keyspace.follower_counts 
+		SET follower_count = follower_count + ?, following_count = following_count + ? 
+		WHERE user_id = ?`
+
+	if err := r.session.Query(query, followerDelta, followingDelta, userID).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to adjust follower counts for user %d: %w", userID, err)
+	}
+	return nil
+}
+
+// ScanUserIDsInTokenRange pages through user ids whose partition token falls in (start, end].
+func (r *UserRepository) ScanUserIDsInTokenRange(ctx context.Context, start, end int64, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	query := `SELECT id FROM threads_keyspace.users WHERE token(id) > ? AND token(id) <= ?`
+
+	iter := r.session.Query(query, start, end).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		ids []int64
+		id  int64
+	)
+	for iter.Scan(&id) {
+		ids = append(ids, id)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return ids, nextPageState, nil
+}
+
+func (r *UserRepository) AddCloseFriend(ctx context.Context, userID, friendID int64, now time.Time) error {
+	query := `INSERT INTO threads_keyspace.close_friends_by_user (user_id, friend_id, added_at) VALUES (?, ?, ?)`
+	return r.session.Query(query, userID, friendID, now).WithContext(ctx).Exec()
+}
+
+func (r *UserRepository) RemoveCloseFriend(ctx context.Context, userID, friendID int64) error {
+	query := `DELETE FROM threads_keyspace.close_friends_by_user WHERE user_id = ? AND friend_id = ?`
+	return r.session.Query(query, userID, friendID).WithContext(ctx).Exec()
+}
+
+func (r *UserRepository) ListCloseFriendIDs(ctx context.Context, userID int64, pageSize int, pagingState []byte) ([]int64, []byte, error) {
+	query := `SELECT friend_id FROM threads_keyspace.close_friends_by_user WHERE user_id = ?`
+
+	iter := r.session.Query(query, userID).
+		WithContext(ctx).
+		PageSize(pageSize).
+		PageState(pagingState).
+		Iter()
+
+	var (
+		friendIDs []int64
+		friendID  int64
+	)
+	for iter.Scan(&friendID) {
+		friendIDs = append(friendIDs, friendID)
+	}
+
+	nextPageState := iter.PageState()
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return friendIDs, nextPageState, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTagChanges(t *testing.T) {
+	tests := []struct {
+		name                     string
+		written, stored, desired []string
+		add, remove              []string
+	}{
+		{"no change", []string{"go"}, []string{"go"}, []string{"go"}, nil, nil},
+		{"plain diff", []string{"go", "rust"}, []string{"go", "rust"}, []string{"go", "zig"}, []string{"zig"}, []string{"rust"}},
+		{"first replace", nil, nil, []string{"go"}, []string{"go"}, nil},
+		{"earlier insert still in flight", []string{"go", "rust"}, []string{"go"}, []string{"go"}, nil, []string{"rust"}},
+		{"earlier delete still in flight", []string{"go"}, []string{"go", "rust"}, []string{"go", "rust"}, []string{"rust"}, nil},
+		{"earlier batch failed", []string{"zig"}, []string{"rust"}, nil, nil, []string{"zig", "rust"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := tagChanges(tt.written, tt.stored, tt.desired)
+			if !slices.Equal(add, tt.add) {
+				t.Errorf("add = %v, want %v", add, tt.add)
+			}
+			if !slices.Equal(remove, tt.remove) {
+				t.Errorf("remove = %v, want %v", remove, tt.remove)
+			}
+		})
+	}
+}
+
+func TestNextTagVersion(t *testing.T) {
+	now := time.UnixMicro(1_000_000)
+
+	tests := []struct {
+		name string
+		prev int64
+		want int64
+	}{
+		{"no previous version", 0, 1_000_000},
+		{"previous version in the past", 999_999, 1_000_000},
+		{"clock behind the previous version", 1_000_000, 1_000_001},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextTagVersion(tt.prev, now); got != tt.want {
+				t.Errorf("nextTagVersion(%d) = %d, want %d", tt.prev, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFollowCacheKey(t *testing.T) {
+	tests := []struct {
+		key    string
+		want   FollowEdge
+		wantOK bool
+	}{
+		{followCacheKey(1, 2), FollowEdge{UserID: 1, FollowingID: 2}, true},
+		{followCacheKey(1<<62, 3), FollowEdge{UserID: 1 << 62, FollowingID: 3}, true},
+		{"user:1:following:2x", FollowEdge{}, false},
+		{"user:1:following:2:extra", FollowEdge{}, false},
+		{"user:a:following:2", FollowEdge{}, false},
+		{"user:1:record", FollowEdge{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := parseFollowCacheKey(tt.key)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseFollowCacheKey(%q) = %v, %v, want %v, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
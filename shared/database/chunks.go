@@ -0,0 +1,15 @@
+package database
+
+import (
+	"iter"
+	"slices"
+)
+
+// MaxInListSize bounds the values bound to one IN restriction. Large multi-partition
+// reads overload the coordinator, so longer lists are split with ChunkIDs.
+const MaxInListSize = 100
+
+// ChunkIDs splits ids into consecutive chunks of at most MaxInListSize, one IN query each.
+func ChunkIDs(ids []int64) iter.Seq[[]int64] {
+	return slices.Chunk(ids, MaxInListSize)
+}
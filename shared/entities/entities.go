@@ -0,0 +1,160 @@
+package entities
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	userv1 "github.com/yaninyzwitty/threads-go-backend/gen/user/v1"
+	"github.com/yaninyzwitty/threads-go-backend/shared/tags"
+)
+
+// MaxUsernameLength bounds how far a mention is scanned after the '@'.
+const MaxUsernameLength = 30
+
+// MaxHashtags is how many distinct hashtags one text can carry. Each is a write to the
+// hashtag index, so further tags stay plain text.
+const MaxHashtags = 10
+
+type Kind int
+
+const (
+	Hashtag Kind = iota + 1
+	Mention
+	URL
+)
+
+// Entity is a span of parsed text. Start and End are offsets in Unicode code points,
+// End exclusive, so clients can linkify without re-parsing.
+type Entity struct {
+	Kind  Kind
+	Start int
+	End   int
+	Text  string // exactly as written, including the '#' or '@'
+	Value string // normalized tag, bare username or URL
+}
+
+// Parse finds hashtags, @mentions and http(s) URLs in text, in order of appearance.
+// Hashtags that fail tag normalization, and new tags past the first MaxHashtags, are
+// left as plain text.
+func Parse(text string) []Entity {
+	runes := []rune(text)
+
+	var out []Entity
+	seenTags := make(map[string]struct{})
+	for i := 0; i < len(runes); {
+		if i > 0 && isWordRune(runes[i-1]) {
+			i++
+			continue
+		}
+
+		switch {
+		case runes[i] == '#':
+			end := i + 1
+			for end < len(runes) && tags.IsTagRune(runes[end]) {
+				end++
+			}
+			if tag, err := tags.Normalize(string(runes[i+1 : end])); err == nil {
+				if _, seen := seenTags[tag]; !seen && len(seenTags) == MaxHashtags {
+					i = end
+					continue
+				}
+				seenTags[tag] = struct{}{}
+				out = append(out, Entity{Kind: Hashtag, Start: i, End: end, Text: string(runes[i:end]), Value: tag})
+				i = end
+				continue
+			}
+
+		case runes[i] == '@':
+			end := i + 1
+			for end < len(runes) && end-i-1 < MaxUsernameLength && isUsernameRune(runes[end]) {
+				end++
+			}
+			// A trailing '.' is sentence punctuation, not part of the handle.
+			for end > i+1 && runes[end-1] == '.' {
+				end--
+			}
+			if end > i+1 {
+				out = append(out, Entity{Kind: Mention, Start: i, End: end, Text: string(runes[i:end]), Value: string(runes[i+1 : end])})
+				i = end
+				continue
+			}
+
+		case hasURLPrefix(runes[i:]):
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) {
+				end++
+			}
+			for end > i && strings.ContainsRune(".,;:!?)]}'\"", runes[end-1]) {
+				end--
+			}
+			raw := string(runes[i:end])
+			if strings.Contains(strings.SplitN(raw, "://", 2)[1], ".") {
+				out = append(out, Entity{Kind: URL, Start: i, End: end, Text: raw, Value: raw})
+				i = end
+				continue
+			}
+		}
+		i++
+	}
+
+	return out
+}
+
+// Values returns the distinct Value of every entity of the given kind, in order.
+func Values(list []Entity, kind Kind) []string {
+	seen := make(map[string]struct{})
+	var values []string
+	for _, e := range list {
+		if e.Kind != kind {
+			continue
+		}
+		if _, ok := seen[e.Value]; ok {
+			continue
+		}
+		seen[e.Value] = struct{}{}
+		values = append(values, e.Value)
+	}
+	return values
+}
+
+// ToProto converts parsed entities into their wire form.
+func ToProto(list []Entity) []*userv1.TextEntity {
+	out := make([]*userv1.TextEntity, 0, len(list))
+	for _, e := range list {
+		var kind userv1.TextEntity_Type
+		switch e.Kind {
+		case Hashtag:
+			kind = userv1.TextEntity_TYPE_HASHTAG
+		case Mention:
+			kind = userv1.TextEntity_TYPE_MENTION
+		case URL:
+			kind = userv1.TextEntity_TYPE_URL
+		}
+		out = append(out, &userv1.TextEntity{
+			Type:  kind,
+			Start: int32(e.Start),
+			End:   int32(e.End),
+			Text:  e.Text,
+			Value: e.Value,
+		})
+	}
+	return out
+}
+
+func isWordRune(r rune) bool {
+	return tags.IsTagRune(r) || r == '@' || r == '#'
+}
+
+func isUsernameRune(r rune) bool {
+	return r == '_' || r == '.' || (r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+}
+
+func hasURLPrefix(runes []rune) bool {
+	for _, prefix := range []string{"https://", "http://"} {
+		if len(runes) >= len(prefix) && strings.EqualFold(string(runes[:len(prefix)]), prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Entity
+	}{
+		{"empty", "", nil},
+		{"plain text", "nothing to see here", nil},
+		{
+			"hashtag",
+			"hello #GoLang!",
+			[]Entity{{Kind: Hashtag, Start: 6, End: 13, Text: "#GoLang", Value: "golang"}},
+		},
+		{
+			"mention",
+			"hi @jane_doe",
+			[]Entity{{Kind: Mention, Start: 3, End: 12, Text: "@jane_doe", Value: "jane_doe"}},
+		},
+		{
+			"mention drops trailing dot",
+			"thanks @jane.",
+			[]Entity{{Kind: Mention, Start: 7, End: 12, Text: "@jane", Value: "jane"}},
+		},
+		{
+			"mention keeps inner dot",
+			"@jane.doe hi",
+			[]Entity{{Kind: Mention, Start: 0, End: 9, Text: "@jane.doe", Value: "jane.doe"}},
+		},
+		{
+			"url drops trailing punctuation",
+			"see https://example.com/a?b=1).",
+			[]Entity{{Kind: URL, Start: 4, End: 29, Text: "https://example.com/a?b=1", Value: "https://example.com/a?b=1"}},
+		},
+		{"url needs a dot", "http://localhost", nil},
+		{"email is not a mention", "mail me@example.com", nil},
+		{"hashtag inside a word", "c#sharp", nil},
+		{"numeric hashtag", "#2024 was long", nil},
+		{"bare symbols", "# @ #", nil},
+		{
+			"offsets are code points",
+			"日本 #東京 @bob",
+			[]Entity{
+				{Kind: Hashtag, Start: 3, End: 6, Text: "#東京", Value: "東京"},
+				{Kind: Mention, Start: 7, End: 11, Text: "@bob", Value: "bob"},
+			},
+		},
+		{
+			"mention is capped",
+			"@" + strings.Repeat("a", MaxUsernameLength+5),
+			[]Entity{{Kind: Mention, Start: 0, End: MaxUsernameLength + 1, Text: "@" + strings.Repeat("a", MaxUsernameLength), Value: strings.Repeat("a", MaxUsernameLength)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.text)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCapsHashtags(t *testing.T) {
+	var text string
+	for i := range MaxHashtags + 2 {
+		text += fmt.Sprintf("#tag%c ", 'a'+i)
+	}
+	text += "#taga"
+
+	got := Values(Parse(text), Hashtag)
+	if len(got) != MaxHashtags {
+		t.Fatalf("Parse kept %d distinct hashtags, want %d: %v", len(got), MaxHashtags, got)
+	}
+	if n := len(Parse(text)); n != MaxHashtags+1 {
+		t.Errorf("Parse found %d entities, want %d; a repeat of a kept tag is still a hashtag", n, MaxHashtags+1)
+	}
+}
+
+func TestValues(t *testing.T) {
+	parsed := Parse("#Go #go @ann #rust @ann @bob")
+
+	tests := []struct {
+		kind Kind
+		want []string
+	}{
+		{Hashtag, []string{"go", "rust"}},
+		{Mention, []string{"ann", "bob"}},
+		{URL, nil},
+	}
+
+	for _, tt := range tests {
+		if got := Values(parsed, tt.kind); !slices.Equal(got, tt.want) {
+			t.Errorf("Values(%d) = %v, want %v", tt.kind, got, tt.want)
+		}
+	}
+}
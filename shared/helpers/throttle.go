@@ -0,0 +1,24 @@
+package helpers
+
+import (
+	"context"
+	"time"
+)
+
+// Throttle returns a func that blocks until the next unit of work may run, at most rate
+// per second. A rate of 0 or less does not limit.
+func Throttle(rate int) (wait func(ctx context.Context) error, stop func()) {
+	if rate <= 0 {
+		return func(ctx context.Context) error { return ctx.Err() }, func() {}
+	}
+
+	ticker := time.NewTicker(time.Second / time.Duration(rate))
+	return func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			return nil
+		}
+	}, ticker.Stop
+}
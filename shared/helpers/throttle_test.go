@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Run("unlimited", func(t *testing.T) {
+		wait, stop := Throttle(0)
+		defer stop()
+
+		start := time.Now()
+		for range 100 {
+			if err := wait(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+			t.Errorf("100 unthrottled waits took %v", elapsed)
+		}
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		wait, stop := Throttle(100)
+		defer stop()
+
+		start := time.Now()
+		for range 5 {
+			if err := wait(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+			t.Errorf("5 waits at 100/s took %v, want at least 40ms", elapsed)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for _, rate := range []int{0, 1} {
+			wait, stop := Throttle(rate)
+			if err := wait(ctx); !errors.Is(err, context.Canceled) {
+				t.Errorf("Throttle(%d) wait on a cancelled context = %v, want context.Canceled", rate, err)
+			}
+			stop()
+		}
+	})
+}
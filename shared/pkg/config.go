@@ -3,6 +3,7 @@ package pkg
 import (
 	"log/slog"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -16,15 +17,43 @@ type Config struct {
 }
 
 type PostServer struct {
-	Port int `yaml:"port"`
+	Port    int    `yaml:"port"`
+	GroupID string `yaml:"group_id"` // own consumer group; timelines need user events too
+	// Authors with at least this many followers are not fanned out; their posts are
+	// merged into home timelines at read time. 0 fans out every post.
+	CelebrityFollowerThreshold int64 `yaml:"celebrity_follower_threshold"`
+	// How long after posting the author may edit a post, e.g. "15m". 0 disables editing.
+	EditWindow time.Duration `yaml:"edit_window"`
+	// Search index backend. Only "embedded", an in-process index for local development,
+	// is available; empty means embedded.
+	SearchBackend string `yaml:"search_backend"`
+	Media         Media  `yaml:"media"`
+}
+
+type Media struct {
+	// Blob store for uploaded files. Only "filesystem", which keeps them under Dir, is
+	// available; empty means filesystem.
+	Backend string `yaml:"backend"`
+	Dir     string `yaml:"dir"`
+	// Base URL clients use to reach this server's upload and file endpoints.
+	PublicURL string `yaml:"public_url"`
+	// Upload limits. Zero or unset uses the media package defaults: 10 MiB, 8192 pixels
+	// and 15m.
+	MaxUploadBytes int64         `yaml:"max_upload_bytes"`
+	MaxDimension   int           `yaml:"max_dimension"` // pixels on either side
+	UploadTTL      time.Duration `yaml:"upload_ttl"`    // how long a reserved upload stays open
 }
 
 type ProcessorServer struct {
-	Port int `yaml:"port"`
+	Port    int    `yaml:"port"`
+	GroupID string `yaml:"group_id"` // own consumer group so derived-data jobs see every event
 }
 
 type UserServer struct {
 	Port int `yaml:"port"`
+	// Address of the internal listener serving /debug/vars, e.g. "127.0.0.1:6061". It
+	// must not be reachable from outside; empty disables it.
+	DebugAddr string `yaml:"debug_addr"`
 }
 
 type Queue struct {
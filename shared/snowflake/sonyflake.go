@@ -11,10 +11,16 @@ import (
 
 var sf *sonyflake.Sonyflake
 
+// startTime is the epoch of generated IDs.
+var startTime = time.Date(2022, time.October, 10, 0, 0, 0, 0, time.UTC)
+
+// timeUnit is the resolution of the time part of an ID.
+const timeUnit = 10 * time.Millisecond
+
 // InitSonyFlake initializes the Sonyflake generator with default settings.
 func InitSonyFlake() error {
 	st := sonyflake.Settings{
-		StartTime: time.Date(2022, time.October, 10, 0, 0, 0, 0, time.UTC),
+		StartTime: startTime,
 	}
 
 	// Initialize the global Sonyflake instance
@@ -40,3 +46,16 @@ func GenerateID() (uint64, error) {
 
 	return id, nil
 }
+
+// IDAt returns the smallest ID generated at or after t. IDs carry their creation time in
+// their high bits, so a time range of posts is a range of IDs (to 10ms resolution).
+func IDAt(t time.Time) int64 {
+	if t.Before(startTime) {
+		return 0
+	}
+	elapsed := t.Sub(startTime) / timeUnit
+	if t.Sub(startTime)%timeUnit != 0 {
+		elapsed++
+	}
+	return int64(elapsed) << (sonyflake.BitLenSequence + sonyflake.BitLenMachineID)
+}
@@ -0,0 +1,47 @@
+package snowflake
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+)
+
+func TestIDAt(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Duration // elapsed time encoded in the id
+	}{
+		{"before epoch", startTime.Add(-time.Hour), 0},
+		{"at epoch", startTime, 0},
+		{"whole units", startTime.Add(time.Second), time.Second},
+		{"rounds up", startTime.Add(time.Second + time.Millisecond), time.Second + timeUnit},
+		{"a year in", startTime.AddDate(1, 0, 0), startTime.AddDate(1, 0, 0).Sub(startTime)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := IDAt(tt.t)
+			if got := sonyflake.ElapsedTime(uint64(id)); got != tt.want {
+				t.Errorf("IDAt(%v) encodes %v, want %v", tt.t, got, tt.want)
+			}
+			if sonyflake.SequenceNumber(uint64(id)) != 0 || sonyflake.MachineID(uint64(id)) != 0 {
+				t.Errorf("IDAt(%v) = %d has low bits set", tt.t, id)
+			}
+		})
+	}
+}
+
+func TestIDAtOrdersWithTime(t *testing.T) {
+	a := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	b := a.Add(timeUnit)
+	if IDAt(a) >= IDAt(b) {
+		t.Errorf("IDAt(%v) = %d is not below IDAt(%v) = %d", a, IDAt(a), b, IDAt(b))
+	}
+	// Every id generated in a's unit sorts below IDAt(b).
+	last := IDAt(a) | (1<<(sonyflake.BitLenSequence+sonyflake.BitLenMachineID) - 1)
+	if last >= IDAt(b) {
+		t.Errorf("last id at %v = %d is not below IDAt(%v) = %d", a, last, b, IDAt(b))
+	}
+}
@@ -0,0 +1,58 @@
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength is the longest tag accepted, counted in runes after normalization.
+const MaxLength = 32
+
+var ErrEmptyTag = errors.New("tag is empty")
+
+// Normalize folds a user supplied tag into its canonical stored form: NFKC, lower case,
+// no leading '#'. Only letters, digits, combining marks and '_' are allowed. A tag needs
+// a letter or digit, and one made only of digits is rejected so "#2024" stays plain text.
+func Normalize(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimPrefix(tag, "#")
+	tag = strings.ToLower(norm.NFKC.String(tag))
+
+	if tag == "" {
+		return "", ErrEmptyTag
+	}
+	if n := utf8.RuneCountInString(tag); n > MaxLength {
+		return "", fmt.Errorf("tag %q is longer than %d characters", tag, MaxLength)
+	}
+
+	hasAlnum, hasNonDigit := false, false
+	for _, r := range tag {
+		if !IsTagRune(r) {
+			return "", fmt.Errorf("tag %q contains invalid character %q", tag, r)
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			hasAlnum = true
+		}
+		if !unicode.IsDigit(r) {
+			hasNonDigit = true
+		}
+	}
+	if !hasAlnum {
+		return "", fmt.Errorf("tag %q must contain a letter or digit", tag)
+	}
+	if !hasNonDigit {
+		return "", fmt.Errorf("tag %q must contain a letter", tag)
+	}
+
+	return tag, nil
+}
+
+// IsTagRune reports whether r may appear inside a tag.
+func IsTagRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc)
+}
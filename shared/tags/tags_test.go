@@ -0,0 +1,45 @@
+package tags
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    string
+		wantErr bool
+	}{
+		{"plain", "golang", "golang", false},
+		{"leading hash", "#golang", "golang", false},
+		{"lower cased", "GoLang", "golang", false},
+		{"trimmed", "  go  ", "go", false},
+		{"underscore", "go_lang", "go_lang", false},
+		{"digits with letters", "web3", "web3", false},
+		{"nfkc full width", "ＧＯ", "go", false},
+		{"non latin", "日本語", "日本語", false},
+		{"combining mark", "café", "café", false},
+		{"max length", strings.Repeat("a", MaxLength), strings.Repeat("a", MaxLength), false},
+		{"empty", "", "", true},
+		{"only hash", "#", "", true},
+		{"too long", strings.Repeat("a", MaxLength+1), "", true},
+		{"space inside", "go lang", "", true},
+		{"punctuation", "go-lang", "", true},
+		{"only underscores", "___", "", true},
+		{"only digits", "2024", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize(%q) error = %v, wantErr %v", tt.tag, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
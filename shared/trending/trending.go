@@ -0,0 +1,12 @@
+// Package trending holds the Redis layout of hashtag trend scores. The processor-service
+// maintains it from post and like events; the post-service only reads it.
+package trending
+
+const (
+	// HashtagsKey is a sorted set of tag -> trend score. Only tags currently trending
+	// are members.
+	HashtagsKey = "trending:hashtags"
+
+	// MaxHashtags is how many tags HashtagsKey keeps; lower scores are trimmed.
+	MaxHashtags = 500
+)